@@ -0,0 +1,60 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/idakhno/golangster/pkg/gen"
+	"github.com/idakhno/golangster/pkg/schema"
+)
+
+const genUsage = `usage: golangster gen -schema events.json|events.yaml [-pkg name] [-o file]
+
+Generates a Go file with one typed slog helper per event of the schema.
+Inside go generate, the package name defaults to $GOPACKAGE:
+
+	//go:generate golangster gen -schema ../log-events.json -o events_gen.go
+`
+
+// runGen implements the gen subcommand and returns the exit code.
+func runGen(args []string) int {
+	fs := flag.NewFlagSet("gen", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), genUsage)
+		fs.PrintDefaults()
+	}
+	schemaPath := fs.String("schema", "", "path of the JSON or YAML event schema")
+	pkg := fs.String("pkg", os.Getenv("GOPACKAGE"), "name of the generated package (default $GOPACKAGE)")
+	out := fs.String("o", "events_gen.go", "output file, - for stdout")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *schemaPath == "" || *pkg == "" || fs.NArg() > 0 {
+		fs.Usage()
+		return 2
+	}
+
+	s, err := schema.Load(*schemaPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "golangster gen:", err)
+		return 1
+	}
+	src, err := gen.Generate(s, gen.Options{Package: *pkg, Source: filepath.Base(*schemaPath)})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "golangster gen:", err)
+		return 1
+	}
+
+	if *out == "-" {
+		_, err = os.Stdout.Write(src)
+	} else {
+		err = os.WriteFile(*out, src, 0o644)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "golangster gen:", err)
+		return 1
+	}
+	return 0
+}
@@ -1,11 +1,17 @@
 package main
 
 import (
+	"os"
+
 	"golang.org/x/tools/go/analysis/singlechecker"
 
 	"github.com/idakhno/golangster/pkg/analyzer"
 )
 
 func main() {
+	// golangster gen generates logging helpers, everything else runs the analyzer
+	if len(os.Args) > 1 && os.Args[1] == "gen" {
+		os.Exit(runGen(os.Args[2:]))
+	}
 	singlechecker.Main(analyzer.Analyzer)
 }
@@ -2,7 +2,10 @@ module github.com/idakhno/golangster
 
 go 1.25.5
 
-require golang.org/x/tools v0.42.0
+require (
+	golang.org/x/tools v0.42.0
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require (
 	golang.org/x/mod v0.33.0 // indirect
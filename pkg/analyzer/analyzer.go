@@ -1,25 +1,98 @@
 package analyzer
 
 import (
+	"fmt"
 	"go/ast"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/passes/inspect"
 	"golang.org/x/tools/go/ast/inspector"
 
 	"github.com/idakhno/golangster/pkg/analyzer/rules"
+	"github.com/idakhno/golangster/pkg/schema"
 )
 
 const name = "golangster"
 const doc = `golangster checks log messages for style and security issues.
 
 Rules:
-  - log messages must start with a lowercase letter
-  - log messages must be written in English only
-  - log messages must not contain special characters or emoji
+  - log messages must start with a lowercase letter, unless the first word
+    is an acronym, a Go identifier or a configured proper noun; with
+    -case-style=sentence they must start with an uppercase letter instead
+  - log messages must be written in English only: letters, digits and
+    punctuation outside the allowed scripts (ASCII by default, more per
+    package) and Latin letters with diacritics are reported
+  - log messages must not contain special characters or emoji; the
+    characters, exceptions and emoji classes are configurable per level
   - log messages must not expose sensitive data (passwords, tokens, etc.)
+  - printf verbs must match the formatting behaviour of the log method
 
-Supported loggers: log, log/slog, go.uber.org/zap.`
+With -english-language, the english rule also scores Latin-script messages
+with an embedded stop-word and n-gram model and reports those that read as
+another language, such as "Verbindung fehlgeschlagen".
+
+With -static-message, structured loggers (slog, zap, logr) must use
+constant messages and pass dynamic values as attributes. With
+-sprintf-message, messages built with fmt.Sprintf are rewritten into
+typed attributes such as slog.Int or zap.Duration.
+
+With -key-style, constant attribute keys must follow a naming convention
+(snake_case, camelCase, kebab-case or dot.separated).
+
+With -key-collisions, attribute keys reserved by the handler (msg, level,
+time, ...) and keys repeated along With chains are reported.
+
+With -dynamic-key, attribute keys must be compile-time constants.
+
+With -key-registry=<package>, attribute keys must be constants declared
+in the given registry package instead of string literals.
+
+With -key-types, a constant attribute key must be logged with values of the
+same kind (string, number, bool, duration) across all packages.
+
+With -schema=<file>, every structured log call must match an event of a
+JSON or YAML schema: a declared message and level, all required attributes, no
+unknown attributes and values of the declared kind.
+
+With -message-shape, messages must not end with a period or colon, start or
+end with whitespace, or contain newlines, tabs or repeated spaces.
+
+With -message-length, resolved constant messages, including constant
+concatenations and printf formats, must stay within character and word limits,
+which can be raised or lowered per level.
+
+With -terminology, banned phrases such as "successfully" or "db" are reported
+with a fix that removes them or substitutes the preferred term.
+
+With -spelling, words close to but not in the embedded English dictionary
+are reported with a fix to the closest word. Extra words come from
+-spelling-words and -spelling-dictionary.
+
+With -template, resolved messages must match or must not match the regular
+expressions of the templates selecting their package, logger and level,
+e.g. error messages starting with "failed to".
+
+With -unicode-safety, bidirectional controls such as U+202E, invisible
+characters such as U+200B, control characters and ANSI escape sequences are
+reported in messages and attribute keys, with a fix that removes them.
+
+With -attribute-key-text, -group-name-text and -logger-name-text the
+english and special-chars rules also check constant attribute keys, group
+names (slog.Group, WithGroup, zap.Namespace) and logger names (zap Named,
+logr WithName), reported under the diagnostic categories attribute-key,
+group-name and logger-name. Names must not contain whitespace either.
+Findings in a named constant are reported where it is used, without a fix.
+
+With -error-strings the same rules are applied to error constructors
+(errors.New, fmt.Errorf, status.Errorf, ...).
+
+Supported loggers: log, log/slog, go.uber.org/zap, github.com/go-logr/logr,
+github.com/sirupsen/logrus.`
 
 // Analyzer is the public instance used in plugins and tests.
 var Analyzer = newAnalyzer(DefaultConfig())
@@ -39,60 +112,601 @@ func newAnalyzer(cfg Config) *analysis.Analyzer {
 		RunDespiteErrors: true,
 		Requires:         []*analysis.Analyzer{inspect.Analyzer},
 	}
+	setKeyTypeFacts(a, cfg.Rules.KeyTypes)
 	// flags for standalone mode (go vet -vettool)
 	a.Flags.BoolVar(&r.cfg.Rules.Lowercase, "lowercase", cfg.Rules.Lowercase,
 		"check that log messages start with a lowercase letter")
+	a.Flags.StringVar((*string)(&r.cfg.CaseStyle), "case-style", string(cfg.CaseStyle),
+		"case of the first letter of log messages: lowercase, sentence or any")
+	a.Flags.Var((*caseStylesFlag)(&r.cfg.CaseStyleByPackage), "case-style-packages",
+		"comma-separated glob=style pairs overriding -case-style per package, e.g. internal/legacy/...=sentence")
+	a.Flags.Var((*stringList)(&r.cfg.ProperNouns), "proper-nouns",
+		"comma-separated words that may start a log message capitalized, e.g. Kafka,Postgres")
 	a.Flags.BoolVar(&r.cfg.Rules.EnglishOnly, "english", cfg.Rules.EnglishOnly,
 		"check that log messages are in English only")
+	a.Flags.Var((*stringList)(&r.cfg.EnglishScripts), "english-scripts",
+		"comma-separated scripts and categories allowed by the english rule: ASCII, Unicode scripts, categories or U+XXXX-U+YYYY ranges")
+	a.Flags.Var((*packageScriptsFlag)(&r.cfg.EnglishScriptsByPackage), "english-scripts-packages",
+		"comma-separated glob=scripts overrides allowing more scripts, e.g. internal/ru/...=Cyrillic")
+	a.Flags.BoolVar(&r.cfg.Rules.EnglishLanguage, "english-language", cfg.Rules.EnglishLanguage,
+		"report Latin-script messages that read as another language, using the embedded English dictionary")
+	a.Flags.Float64Var(&r.cfg.EnglishThreshold, "english-threshold", cfg.englishThreshold(),
+		"confidence from which -english-language reports a message as another language")
+	a.Flags.Var((*stringList)(&r.cfg.EnglishAllowlist), "english-allowlist",
+		"comma-separated words accepted by the english rule, e.g. Nestlé,Zürich")
 	a.Flags.BoolVar(&r.cfg.Rules.NoSpecialChars, "special-chars", cfg.Rules.NoSpecialChars,
 		"check that log messages contain no special characters or emoji")
+	a.Flags.StringVar(&r.cfg.SpecialChars.Forbidden, "special-chars-forbidden", cfg.SpecialChars.Forbidden,
+		"characters reported by the special-chars rule")
+	a.Flags.StringVar(&r.cfg.SpecialChars.Allowed, "special-chars-allowed", cfg.SpecialChars.Allowed,
+		"characters accepted by the special-chars rule even if forbidden or emoji, e.g. →°")
+	a.Flags.Var((*stringList)(&r.cfg.SpecialChars.Emoji), "special-chars-emoji",
+		"comma-separated emoji classes: U+XXXX-U+YYYY ranges, Unicode categories, scripts or properties, Extended_Pictographic")
+	a.Flags.Var(levelCharsFlag{policies: &r.cfg.SpecialCharsByLevel, field: forbiddenChars}, "special-chars-forbidden-by-level",
+		"per-level forbidden characters as level=characters, repeated for several levels, e.g. debug=!")
+	a.Flags.Var(levelCharsFlag{policies: &r.cfg.SpecialCharsByLevel, field: allowedChars}, "special-chars-allowed-by-level",
+		"per-level additionally allowed characters as level=characters, repeated for several levels, e.g. debug=?")
 	a.Flags.BoolVar(&r.cfg.Rules.NoSensitive, "sensitive", cfg.Rules.NoSensitive,
 		"check that log messages do not expose sensitive data")
+	a.Flags.BoolVar(&r.cfg.Rules.FormatMismatch, "format-mismatch", cfg.Rules.FormatMismatch,
+		"check that printf verbs match the formatting behaviour of the log method")
+	a.Flags.BoolVar(&r.cfg.Rules.MessageShape, "message-shape", cfg.Rules.MessageShape,
+		"check log messages for trailing punctuation, stray whitespace and newlines")
+	a.Flags.BoolVar(&r.cfg.Rules.MessageLength, "message-length", cfg.Rules.MessageLength,
+		"check log messages against character and word limits")
+	a.Flags.IntVar(&r.cfg.MessageLength.MaxChars, "message-max-chars", cfg.MessageLength.MaxChars,
+		"maximum number of characters in a log message, 0 disables the limit")
+	a.Flags.IntVar(&r.cfg.MessageLength.MaxWords, "message-max-words", cfg.MessageLength.MaxWords,
+		"maximum number of words in a log message, 0 disables the limit")
+	a.Flags.IntVar(&r.cfg.MessageLength.MinWords, "message-min-words", cfg.MessageLength.MinWords,
+		"minimum number of words in a log message, 0 disables the limit")
+	a.Flags.Var(levelLimitsFlag{limits: &r.cfg.MessageLengthByLevel, field: maxChars}, "message-max-chars-by-level",
+		"per-level maximum number of characters, e.g. debug=400,info=200")
+	a.Flags.Var(levelLimitsFlag{limits: &r.cfg.MessageLengthByLevel, field: maxWords}, "message-max-words-by-level",
+		"per-level maximum number of words, e.g. debug=60")
+	a.Flags.Var(levelLimitsFlag{limits: &r.cfg.MessageLengthByLevel, field: minWords}, "message-min-words-by-level",
+		"per-level minimum number of words, e.g. error=3")
+	a.Flags.BoolVar(&r.cfg.Rules.Terminology, "terminology", cfg.Rules.Terminology,
+		"check log messages for banned phrases")
+	a.Flags.Var((*termsFlag)(&r.cfg.Terminology), "terminology-terms",
+		"comma-separated phrase=replacement pairs, an empty replacement removes the phrase")
+	a.Flags.BoolVar(&r.cfg.Rules.Spelling, "spelling", cfg.Rules.Spelling,
+		"check log messages for misspelled words")
+	a.Flags.Var((*stringList)(&r.cfg.SpellingWords), "spelling-words",
+		"comma-separated words accepted by the spelling rule")
+	a.Flags.StringVar(&r.cfg.SpellingDictionary, "spelling-dictionary", cfg.SpellingDictionary,
+		"path of a project dictionary file for the spelling rule")
+	a.Flags.BoolVar(&r.cfg.Rules.Template, "template", cfg.Rules.Template,
+		"check log messages against per-package, logger and level templates")
+	a.Flags.Var((*templatesFlag)(&r.cfg.Templates), "template-rules",
+		`JSON array of templates, e.g. [{"level":"error","match":"^failed to ","explanation":"..."}]`)
+	a.Flags.BoolVar(&r.cfg.Rules.UnicodeSafety, "unicode-safety", cfg.Rules.UnicodeSafety,
+		"check for bidi controls, invisible and control characters and ANSI escape sequences")
+	a.Flags.BoolVar(&r.cfg.Rules.AttributeKeyText, "attribute-key-text", cfg.Rules.AttributeKeyText,
+		"apply the english and special-chars rules to attribute keys")
+	a.Flags.BoolVar(&r.cfg.Rules.GroupNameText, "group-name-text", cfg.Rules.GroupNameText,
+		"apply the english and special-chars rules to group names")
+	a.Flags.BoolVar(&r.cfg.Rules.LoggerNameText, "logger-name-text", cfg.Rules.LoggerNameText,
+		"apply the english and special-chars rules to zap Named and logr WithName names")
+	a.Flags.BoolVar(&r.cfg.Rules.StaticMessage, "static-message", cfg.Rules.StaticMessage,
+		"check that structured log messages are constant strings")
+	a.Flags.BoolVar(&r.cfg.Rules.SprintfMessage, "sprintf-message", cfg.Rules.SprintfMessage,
+		"check that structured log messages are not built with fmt.Sprintf")
+	a.Flags.BoolVar(&r.cfg.Rules.KeyStyle, "key-style", cfg.Rules.KeyStyle,
+		"check that attribute keys follow a naming convention")
+	a.Flags.StringVar((*string)(&r.cfg.KeyStyle), "key-style-convention", string(cfg.KeyStyle),
+		"attribute key convention: snake_case, camelCase, kebab-case or dot.separated")
+	a.Flags.Var((*stringList)(&r.cfg.KeyStyleExceptions), "key-style-exceptions",
+		"comma-separated attribute keys exempt from the key-style rule")
+	a.Flags.BoolVar(&r.cfg.Rules.KeyCollisions, "key-collisions", cfg.Rules.KeyCollisions,
+		"check for reserved and duplicate attribute keys")
+	for _, logger := range []string{"slog", "zap", "logr"} {
+		a.Flags.Var(reservedKeysFlag{keys: &r.cfg.ReservedKeys, logger: logger}, "reserved-keys-"+logger,
+			"comma-separated attribute keys reserved by the "+logger+" handler")
+	}
+	a.Flags.BoolVar(&r.cfg.Rules.DynamicKey, "dynamic-key", cfg.Rules.DynamicKey,
+		"check that attribute keys are compile-time constants")
+	a.Flags.Var(&keyTypesFlag{a: a, enabled: &r.cfg.Rules.KeyTypes}, "key-types",
+		"check that attribute keys are logged with values of one kind across packages")
+	a.Flags.StringVar(&r.cfg.KeyRegistry, "key-registry", cfg.KeyRegistry,
+		"import path of a package declaring all attribute keys as constants")
+	a.Flags.StringVar(&r.cfg.Schema, "schema", cfg.Schema,
+		"path of a JSON or YAML event schema that structured log calls must match")
+	a.Flags.BoolVar(&r.cfg.Rules.ErrorStrings, "error-strings", cfg.Rules.ErrorStrings,
+		"apply the message rules to errors.New, fmt.Errorf and similar constructors")
+	a.Flags.Var((*stringList)(&r.cfg.ErrorStringExemptPrefixes), "error-strings-exempt-prefixes",
+		"comma-separated prefixes that exempt an error string from the lowercase check")
 	return a
 }
 
 type runner struct {
 	cfg Config
+
+	// the schema is loaded once and shared by all packages
+	schemaOnce sync.Once
+	schema     *schema.Schema
+	schemaErr  error
+
+	// the dictionary is built once as well
+	dictOnce sync.Once
+	dict     *rules.Dictionary
+	dictErr  error
+
+	englishOnce sync.Once
+	english     *rules.LanguageDetector
+
+	// compiled script policies; the last one is the default policy
+	scriptsOnce sync.Once
+	scripts     []packageScripts
+	scriptsErr  error
+
+	// compiled character policies, by level; "" is the default policy
+	charsOnce sync.Once
+	chars     map[string]*rules.CharSet
+	charsErr  error
+
+	templatesOnce sync.Once
+	templates     []*rules.CompiledTemplate
+	templatesErr  error
+}
+
+// loadSchema returns the configured event schema, nil if there is none.
+func (r *runner) loadSchema() (*schema.Schema, error) {
+	if r.cfg.Schema == "" {
+		return nil, nil
+	}
+	r.schemaOnce.Do(func() {
+		r.schema, r.schemaErr = schema.Load(r.cfg.Schema)
+	})
+	return r.schema, r.schemaErr
+}
+
+// loadDictionary returns the spelling dictionary with the configured words.
+func (r *runner) loadDictionary() (*rules.Dictionary, error) {
+	r.dictOnce.Do(func() {
+		words := r.cfg.SpellingWords
+		if r.cfg.SpellingDictionary != "" {
+			data, err := os.ReadFile(r.cfg.SpellingDictionary)
+			if err != nil {
+				r.dictErr = fmt.Errorf("read spelling dictionary: %w", err)
+				return
+			}
+			words = append(rules.ParseWordList(string(data)), words...)
+		}
+		r.dict = rules.NewDictionary(words)
+	})
+	return r.dict, r.dictErr
+}
+
+// loadLanguageDetector returns the language detector of the english rule.
+// The embedded dictionary is only built for the language scoring, apart from
+// the spelling dictionary so that the spelling settings do not change it.
+func (r *runner) loadLanguageDetector() *rules.LanguageDetector {
+	r.englishOnce.Do(func() {
+		threshold := r.cfg.englishThreshold()
+		var dict *rules.Dictionary
+		if r.cfg.Rules.EnglishLanguage && threshold <= 1 {
+			dict = rules.NewDictionary(nil)
+		}
+		allowlist := append(append([]string(nil), r.cfg.EnglishAllowlist...), r.cfg.ProperNouns...)
+		r.english = rules.NewLanguageDetector(dict, threshold, allowlist)
+	})
+	return r.english
+}
+
+// packageScripts is the compiled script policy of the packages matching a glob.
+type packageScripts struct {
+	pattern string
+	policy  *rules.ScriptPolicy
+}
+
+// compileScripts compiles the default and per-package script policies of
+// the english rule.
+func (r *runner) compileScripts() error {
+	r.scriptsOnce.Do(func() {
+		base := r.cfg.EnglishScripts
+		if len(base) == 0 {
+			base = rules.DefaultScripts
+		}
+		for _, p := range r.cfg.EnglishScriptsByPackage {
+			policy, err := rules.CompileScripts(append(append([]string(nil), base...), p.Scripts...))
+			if err != nil {
+				r.scriptsErr = fmt.Errorf("english scripts of %s: %w", p.Package, err)
+				return
+			}
+			r.scripts = append(r.scripts, packageScripts{p.Package, policy})
+		}
+		policy, err := rules.CompileScripts(base)
+		if err != nil {
+			r.scriptsErr = fmt.Errorf("english scripts: %w", err)
+			return
+		}
+		r.scripts = append(r.scripts, packageScripts{"", policy})
+	})
+	return r.scriptsErr
+}
+
+// scriptPolicy returns the script policy of the package with import path
+// pkgPath.
+func (r *runner) scriptPolicy(pkgPath string) *rules.ScriptPolicy {
+	for _, p := range r.scripts {
+		if rules.MatchPackage(p.pattern, pkgPath) {
+			return p.policy
+		}
+	}
+	return nil
+}
+
+// compileCharSets compiles the character policies of the special-chars rule.
+func (r *runner) compileCharSets() error {
+	r.charsOnce.Do(func() {
+		r.chars = make(map[string]*rules.CharSet, len(r.cfg.SpecialCharsByLevel)+1)
+		r.chars[""], r.charsErr = r.cfg.SpecialChars.Compile()
+		for level, p := range r.cfg.SpecialCharsByLevel {
+			if r.charsErr != nil {
+				return
+			}
+			r.chars[level], r.charsErr = r.cfg.SpecialChars.Merge(p).Compile()
+		}
+		if r.charsErr != nil {
+			r.charsErr = fmt.Errorf("special chars: %w", r.charsErr)
+		}
+	})
+	return r.charsErr
+}
+
+// charSet returns the character policy of a log level.
+func (r *runner) charSet(level string) *rules.CharSet {
+	if set, ok := r.chars[level]; ok {
+		return set
+	}
+	return r.chars[""]
+}
+
+// compileTemplates returns the compiled templates of the template rule.
+func (r *runner) compileTemplates() ([]*rules.CompiledTemplate, error) {
+	r.templatesOnce.Do(func() {
+		for i, t := range r.cfg.Templates {
+			c, err := t.Compile()
+			if err != nil {
+				r.templatesErr = fmt.Errorf("template %d: %w", i+1, err)
+				return
+			}
+			r.templates = append(r.templates, c)
+		}
+	})
+	return r.templates, r.templatesErr
 }
 
 func (r *runner) run(pass *analysis.Pass) (interface{}, error) {
+	if r.cfg.Rules.KeyStyle && !r.cfg.KeyStyle.Valid() {
+		return nil, fmt.Errorf("unknown key style %q", r.cfg.KeyStyle)
+	}
+	if r.cfg.Rules.Lowercase {
+		if style := r.cfg.caseStyle(pass.Pkg.Path()); !style.Valid() {
+			return nil, fmt.Errorf("unknown case style %q", style)
+		}
+	}
+
+	events, err := r.loadSchema()
+	if err != nil {
+		return nil, err
+	}
+	if r.cfg.Rules.Spelling {
+		if _, err := r.loadDictionary(); err != nil {
+			return nil, err
+		}
+	}
+	if r.cfg.Rules.Template {
+		if _, err := r.compileTemplates(); err != nil {
+			return nil, err
+		}
+	}
+	if r.cfg.Rules.EnglishOnly {
+		r.loadLanguageDetector()
+		if err := r.compileScripts(); err != nil {
+			return nil, err
+		}
+	}
+	if r.cfg.Rules.NoSpecialChars {
+		if err := r.compileCharSets(); err != nil {
+			return nil, err
+		}
+	}
+
 	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
 
 	nodeFilter := []ast.Node{
 		(*ast.CallExpr)(nil),
+		(*ast.CompositeLit)(nil),
 	}
 
-	insp.Preorder(nodeFilter, func(n ast.Node) {
-		call := n.(*ast.CallExpr)
+	var collector keyCollector
+	if r.cfg.Rules.KeyCollisions || events != nil {
+		collector = keyCollector{typesInfo: pass.TypesInfo, defs: collectLocalDefs(pass.TypesInfo, pass.Files)}
+	}
 
-		logCall, ok := FindLogCall(pass.TypesInfo, call)
-		if !ok {
-			return
-		}
+	var registry *rules.Registry
+	if r.cfg.KeyRegistry != "" && r.cfg.KeyRegistry != pass.Pkg.Path() {
+		registry = loadRegistry(pass, r.cfg.KeyRegistry)
+	}
 
-		// apply rules to each string literal found in the message
-		for _, lit := range logCall.Literals {
-			msg, ok := UnquoteStringLit(lit)
-			if !ok {
-				continue
-			}
+	var keyTypes *keyTypeChecker
+	if r.cfg.Rules.KeyTypes {
+		keyTypes = newKeyTypeChecker(pass)
+	}
 
-			if r.cfg.Rules.Lowercase {
-				rules.CheckLowercase(pass, msg, lit)
+	insp.Preorder(nodeFilter, func(n ast.Node) {
+		var keys []AttrKey
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			if logCall, ok := FindLogCall(pass.TypesInfo, n); ok {
+				r.checkLogCall(pass, logCall)
+				if events != nil && logCall.Kind.Structured() {
+					checkSchema(pass, events, collector, logCall)
+				}
+			} else if r.cfg.Rules.ErrorStrings {
+				if errCall, ok := FindErrorCall(pass.TypesInfo, n); ok {
+					r.checkErrorCall(pass, errCall)
+				}
 			}
-			if r.cfg.Rules.EnglishOnly {
-				rules.CheckEnglish(pass, msg, lit)
+			if r.cfg.Rules.KeyCollisions {
+				r.checkKeyCollisions(pass, collector, n)
 			}
-			if r.cfg.Rules.NoSpecialChars {
-				rules.CheckSpecialChars(pass, msg, lit)
+			if r.cfg.Rules.LoggerNameText {
+				if expr, ok := FindLoggerName(pass.TypesInfo, n); ok {
+					if name, ok := ConstMessage(pass.TypesInfo, expr); ok {
+						r.checkName(pass, rules.LoggerName, expr, name)
+					}
+				}
 			}
+			keys = FindAttrKeys(pass.TypesInfo, n)
+
+		case *ast.CompositeLit:
+			keys = FindFieldsKeys(pass.TypesInfo, n)
 		}
 
-		// sensitive rule inspects the full call including variable names
-		if r.cfg.Rules.NoSensitive {
-			rules.CheckSensitive(pass, call, r.cfg.effectiveKeywords())
+		for _, key := range keys {
+			r.checkAttrKey(pass, key)
+			if registry != nil {
+				checkKeyRegistry(pass, registry, key)
+			}
+			if keyTypes != nil {
+				keyTypes.check(key)
+			}
 		}
 	})
 
+	if keyTypes != nil {
+		keyTypes.export()
+	}
 	return nil, nil
 }
+
+func (r *runner) checkLogCall(pass *analysis.Pass, logCall LogCall) {
+	style := r.cfg.caseStyle(pass.Pkg.Path())
+	first, _ := edgeLiterals(logCall.Expr)
+	level := logCall.Level(pass.TypesInfo)
+
+	// apply rules to each string literal found in the message
+	for _, lit := range logCall.Literals {
+		msg, ok := UnquoteStringLit(lit)
+		if !ok {
+			continue
+		}
+
+		// only the start of the message is capitalized in sentence case
+		if r.cfg.Rules.Lowercase && (style != rules.SentenceCase || lit == first) {
+			rules.CheckCaseStyle(pass, rules.LogMessage, msg, lit, 0, style, r.cfg.ProperNouns)
+		}
+		r.checkText(pass, rules.LogMessage, msg, lit, level)
+		if r.cfg.Rules.MessageShape {
+			rules.CheckMessageShape(pass, rules.LogMessage, msg, lit, logShape(logCall, lit))
+		}
+	}
+
+	// sensitive rule inspects the full message including variable names
+	if r.cfg.Rules.NoSensitive {
+		rules.CheckSensitive(pass, rules.LogMessage, logCall.Expr, r.cfg.effectiveKeywords())
+	}
+
+	if r.cfg.Rules.MessageLength || r.cfg.Rules.Template {
+		if msg, format, ok := resolvedMessage(pass, logCall); ok {
+			if r.cfg.Rules.MessageLength {
+				rules.CheckMessageLength(pass, rules.LogMessage, logCall.Expr, msg, format, r.cfg.messageLimits(level))
+			}
+			if r.cfg.Rules.Template {
+				r.checkTemplates(pass, logCall, msg, level)
+			}
+		}
+	}
+
+	if r.cfg.Rules.FormatMismatch {
+		if fc, ok := formatCall(pass.TypesInfo, logCall); ok {
+			rules.CheckFormatMismatch(pass, fc)
+		}
+	}
+
+	if logCall.Kind.Structured() {
+		// a Sprintf message gets the more specific typed attribute fix
+		if r.cfg.Rules.SprintfMessage && rules.IsSprintfCall(pass, logCall.Expr) {
+			rules.CheckSprintfMessage(pass, logCall.Expr, r.cfg.KeyStyle, attrRenderer(pass, logCall, true))
+		} else if r.cfg.Rules.StaticMessage {
+			rules.CheckStaticMessage(pass, logCall.Expr, r.cfg.KeyStyle, attrRenderer(pass, logCall, false))
+		}
+	}
+}
+
+// checkTemplates checks the resolved message of logCall against the templates
+// selecting the call. msg must be the whole message, see resolvedMessage.
+func (r *runner) checkTemplates(pass *analysis.Pass, logCall LogCall, msg, level string) {
+	for _, t := range r.templates {
+		if t.Applies(pass.Pkg.Path(), logCall.Kind.String(), level) {
+			rules.CheckTemplate(pass, rules.LogMessage, logCall.Expr, msg, t)
+		}
+	}
+}
+
+func (r *runner) checkAttrKey(pass *analysis.Pass, key AttrKey) {
+	if key.Expr == nil {
+		return
+	}
+	if !key.Const {
+		if r.cfg.Rules.DynamicKey && isString(pass.TypesInfo.TypeOf(key.Expr)) {
+			rules.CheckDynamicKey(pass, key.Expr)
+		}
+		return
+	}
+	if r.cfg.Rules.KeyStyle {
+		rules.CheckKeyStyle(pass, key.Expr, key.Name, r.cfg.KeyStyle, r.cfg.KeyStyleExceptions)
+	}
+	subject, text := rules.AttributeKey, r.cfg.Rules.AttributeKeyText
+	if key.Group {
+		subject, text = rules.GroupName, r.cfg.Rules.GroupNameText
+	}
+	if r.cfg.Rules.UnicodeSafety {
+		if lit, ok := ast.Unparen(key.Expr).(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			rules.CheckUnicodeSafety(pass, subject, key.Name, lit)
+		} else {
+			rules.CheckUnicodeSafetyConst(pass, subject, key.Expr, key.Name)
+		}
+	}
+	if text {
+		r.checkName(pass, subject, key.Expr, key.Name)
+	}
+}
+
+// checkName applies the english and special-chars rules to the constant
+// name of an attribute, group or logger. Names are not checked per level.
+// A named constant is reported at expr, without fixes.
+func (r *runner) checkName(pass *analysis.Pass, subject rules.Subject, expr ast.Expr, name string) {
+	lit, ok := ast.Unparen(expr).(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		pass = reportAt(pass, expr)
+		lit = &ast.BasicLit{ValuePos: expr.Pos(), Kind: token.STRING, Value: strconv.Quote(name)}
+	}
+	if r.cfg.Rules.EnglishOnly {
+		rules.CheckEnglish(pass, subject, name, lit, r.scriptPolicy(pass.Pkg.Path()), r.english)
+	}
+	if r.cfg.Rules.NoSpecialChars {
+		rules.CheckSpecialChars(pass, subject, name, lit, r.charSet(""))
+	}
+}
+
+// reportAt returns a copy of pass that reports every diagnostic at node,
+// without fixes, for findings in a constant declared elsewhere.
+func reportAt(pass *analysis.Pass, node ast.Node) *analysis.Pass {
+	p := *pass
+	p.Report = func(d analysis.Diagnostic) {
+		d.Pos, d.End, d.SuggestedFixes = node.Pos(), node.End(), nil
+		pass.Report(d)
+	}
+	return &p
+}
+
+// checkKeyCollisions checks the keys a log or With call attaches against the
+// keys attached earlier in its logger chain.
+func (r *runner) checkKeyCollisions(pass *analysis.Pass, collector keyCollector, call *ast.CallExpr) {
+	var kind LoggerKind
+	if logCall, ok := FindLogCall(pass.TypesInfo, call); ok {
+		kind = logCall.Kind
+	} else if withKind, method, ok := findWithCall(pass.TypesInfo, call); ok && method != "WithGroup" {
+		kind = withKind
+	} else {
+		return
+	}
+
+	prior, prefix := collector.chainKeys(call.Fun.(*ast.SelectorExpr).X)
+	own, _ := collector.callKeys(call, prefix)
+	if len(own) == 0 {
+		return
+	}
+	rules.CheckKeyCollisions(pass, prior, own, r.cfg.reservedKeys(kind))
+}
+
+// checkSchema checks a structured log call, with the keys attached along its
+// logger chain, against the event schema.
+func checkSchema(pass *analysis.Pass, events *schema.Schema, collector keyCollector, logCall LogCall) {
+	call := rules.SchemaCall{Msg: logCall.Expr, Level: logCall.Level(pass.TypesInfo)}
+	call.Message, call.Const = ConstMessage(pass.TypesInfo, logCall.Expr)
+
+	var prefix string
+	if sel, ok := logCall.Call.Fun.(*ast.SelectorExpr); ok {
+		call.Prior, prefix = collector.chainKeys(sel.X)
+	}
+	call.Own, _ = collector.callKeys(logCall.Call, prefix)
+	rules.CheckSchema(pass, events, call)
+}
+
+// checkKeyRegistry reports a string literal attribute key.
+func checkKeyRegistry(pass *analysis.Pass, registry *rules.Registry, key AttrKey) {
+	if key.Group || key.Expr == nil {
+		return
+	}
+	lit, ok := ast.Unparen(key.Expr).(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return
+	}
+	rules.CheckKeyRegistry(pass, fileOf(pass, lit.Pos()), lit, key.Name, registry,
+		importName(pass, lit.Pos(), registry.Path))
+}
+
+func (r *runner) checkErrorCall(pass *analysis.Pass, errCall ErrorCall) {
+	for i, lit := range errCall.Literals {
+		msg, ok := UnquoteStringLit(lit)
+		if !ok {
+			continue
+		}
+
+		// a leading "%w: " wraps another error, the style applies to the rest
+		off := 0
+		if i == 0 && errCall.Format {
+			off = rules.WrapPrefixLen(msg)
+		}
+		if r.cfg.Rules.Lowercase && !r.cfg.isExemptErrorString(msg[off:]) {
+			rules.CheckLowercaseFrom(pass, rules.ErrorString, msg, lit, off, r.cfg.ProperNouns)
+		}
+		r.checkText(pass, rules.ErrorString, msg, lit, "")
+		if r.cfg.Rules.MessageShape {
+			first, last := edgeLiterals(errCall.Expr)
+			rules.CheckMessageShape(pass, rules.ErrorString, msg, lit,
+				rules.ShapeContext{First: lit == first, Last: lit == last})
+		}
+	}
+
+	if r.cfg.Rules.NoSensitive {
+		rules.CheckSensitive(pass, rules.ErrorString, errCall.Expr, r.cfg.effectiveKeywords())
+	}
+}
+
+// logShape returns the position of lit in the message of logCall and the
+// newline handling of the standard log package.
+func logShape(logCall LogCall, lit *ast.BasicLit) rules.ShapeContext {
+	first, last := edgeLiterals(logCall.Expr)
+	ctx := rules.ShapeContext{First: lit == first, Last: lit == last && logCall.MessageComplete()}
+	if logCall.Kind == KindLog {
+		ctx.AddsNewline = strings.HasSuffix(logCall.Method, "ln")
+		ctx.TrailingNewline = !ctx.AddsNewline
+	}
+	return ctx
+}
+
+// checkText applies the content rules that do not depend on the message
+// position. level selects the character policy, "" is the default.
+func (r *runner) checkText(pass *analysis.Pass, subject rules.Subject, msg string, lit *ast.BasicLit, level string) {
+	if r.cfg.Rules.EnglishOnly {
+		rules.CheckEnglish(pass, subject, msg, lit, r.scriptPolicy(pass.Pkg.Path()), r.english)
+	}
+	if r.cfg.Rules.NoSpecialChars {
+		rules.CheckSpecialChars(pass, subject, msg, lit, r.charSet(level))
+	}
+	if r.cfg.Rules.Terminology {
+		rules.CheckTerminology(pass, subject, msg, lit, r.cfg.Terminology)
+	}
+	if r.cfg.Rules.Spelling {
+		rules.CheckSpelling(pass, subject, msg, lit, r.dict)
+	}
+	if r.cfg.Rules.UnicodeSafety {
+		rules.CheckUnicodeSafety(pass, subject, msg, lit)
+	}
+}
@@ -1,28 +1,352 @@
 package analyzer_test
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 
 	"golang.org/x/tools/go/analysis/analysistest"
 
 	"github.com/idakhno/golangster/pkg/analyzer"
+	"github.com/idakhno/golangster/pkg/analyzer/rules"
 )
 
-func TestAnalyzer(t *testing.T) {
-	// resolve testdata path relative to the package directory
+// testdataDir resolves the testdata path relative to the package directory.
+func testdataDir(t *testing.T) string {
+	t.Helper()
 	wd, err := os.Getwd()
 	if err != nil {
 		t.Fatal(err)
 	}
 	// pkg/analyzer is two levels below the project root
-	testdata := filepath.Join(wd, "..", "..", "testdata")
+	return filepath.Join(wd, "..", "..", "testdata")
+}
 
-	analysistest.Run(t, testdata, analyzer.Analyzer,
-		"lowercase",
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, testdataDir(t), analyzer.Analyzer,
 		"english",
-		"special_chars",
 		"sensitive",
 	)
 }
+
+func TestAnalyzerEnglishLatin(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules = analyzer.RulesConfig{EnglishOnly: true, EnglishLanguage: true}
+	cfg.EnglishAllowlist = []string{"Z\u00fcrich"}
+	cfg.ProperNouns = []string{"Nestl\u00e9"}
+
+	analysistest.Run(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "english_latin")
+}
+
+func TestAnalyzerEnglishScripts(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules = analyzer.RulesConfig{EnglishOnly: true}
+	cfg.EnglishScriptsByPackage = []rules.PackageScripts{
+		{Package: "english_scripts/ru", Scripts: []string{"Cyrillic"}},
+		{Package: "english_scripts/fr", Scripts: []string{"Latin"}},
+	}
+
+	analysistest.Run(t, testdataDir(t), analyzer.NewAnalyzer(cfg),
+		"english_scripts", "english_scripts/ru", "english_scripts/fr")
+}
+
+func TestAnalyzerLowercase(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.ProperNouns = []string{"Kafka", "Postgres"}
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "lowercase")
+}
+
+func TestAnalyzerCaseStyle(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules = analyzer.RulesConfig{Lowercase: true, ErrorStrings: true}
+	cfg.CaseStyle = rules.SentenceCase
+	cfg.CaseStyleByPackage = []rules.PackageCaseStyle{
+		{Package: "case_style/legacy", Style: rules.LowerCase},
+		{Package: "case_style/*", Style: rules.AnyCase},
+	}
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t), analyzer.NewAnalyzer(cfg),
+		"case_style", "case_style/legacy", "case_style/cli")
+}
+
+// TestAnalyzerRanges checks that diagnostics cover exactly the offending
+// source bytes of a literal, escape sequences included.
+func TestAnalyzerRanges(t *testing.T) {
+	results := analysistest.Run(t, testdataDir(t), analyzer.Analyzer, "ranges")
+
+	var got []string
+	for _, r := range results {
+		for _, d := range r.Diagnostics {
+			file := r.Pass.Fset.File(d.Pos)
+			src, err := os.ReadFile(file.Name())
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, string(src[file.Offset(d.Pos):file.Offset(d.End)]))
+		}
+	}
+	want := []string{
+		"Starting", `\x41bc`,
+		`\u0434\u0430`, `\u043d\u0435\u0442`,
+		"!!", "?",
+		`\U0001F680`, `\U0001F389`,
+		"...", `\x2e..`,
+		"password", "token",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("diagnostic ranges = %q, want %q", got, want)
+	}
+}
+
+func TestAnalyzerSpecialChars(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t), analyzer.Analyzer, "special_chars")
+}
+
+func TestAnalyzerSpecialCharsPolicy(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.SpecialChars = rules.CharPolicy{
+		Forbidden: "!?\"`",
+		Allowed:   "\u2192\u00b0",
+		Emoji:     []string{"Extended_Pictographic"},
+	}
+	cfg.SpecialCharsByLevel = map[string]rules.CharPolicy{"debug": {Allowed: "?"}}
+
+	analysistest.Run(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "special_chars_policy")
+}
+
+func TestSpecialCharsFlags(t *testing.T) {
+	a := analyzer.NewAnalyzer(analyzer.DefaultConfig())
+	for _, f := range []struct{ name, value string }{
+		{"special-chars-forbidden", `"`},
+		{"special-chars-allowed", "; "},
+		{"special-chars-forbidden-by-level", `debug=,"`},
+		{"special-chars-allowed-by-level", `error=", `},
+	} {
+		if err := a.Flags.Set(f.name, f.value); err != nil {
+			t.Fatalf("-%s=%s: %v", f.name, f.value, err)
+		}
+		if got := a.Flags.Lookup(f.name).Value.String(); got != f.value {
+			t.Errorf("-%s = %q, want %q", f.name, got, f.value)
+		}
+	}
+
+	analysistest.Run(t, testdataDir(t), a, "special_chars_flags")
+}
+
+func TestAnalyzerUnicodeSafety(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules = analyzer.RulesConfig{UnicodeSafety: true}
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "unicode_safety")
+}
+
+func TestAnalyzerNameChecks(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules = analyzer.RulesConfig{
+		EnglishOnly:      true,
+		NoSpecialChars:   true,
+		AttributeKeyText: true,
+		GroupNameText:    true,
+		LoggerNameText:   true,
+	}
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "name_checks")
+}
+
+func TestAnalyzerErrorStrings(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules.ErrorStrings = true
+	cfg.ErrorStringExemptPrefixes = []string{"EOF", "HTTP"}
+
+	analysistest.Run(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "error_strings")
+}
+
+func TestAnalyzerStaticMessage(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules.StaticMessage = true
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "static_message")
+}
+
+func TestAnalyzerSprintfMessage(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules.SprintfMessage = true
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "sprintf_message")
+}
+
+func TestAnalyzerFormatMismatch(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules.FormatMismatch = true
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "format_mismatch")
+}
+
+func TestAnalyzerKeyStyle(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules.KeyStyle = true
+	cfg.KeyStyleExceptions = []string{"requestURI"}
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "key_style")
+}
+
+func TestAnalyzerKeyCollisions(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules.KeyCollisions = true
+
+	analysistest.Run(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "key_collisions")
+}
+
+// TestAnalyzerKeyCollisionsRelated checks that duplicates point at the first
+// use of the key. analysistest ignores related information, so the positions
+// are read from the checker actions.
+func TestAnalyzerKeyCollisionsRelated(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules.KeyCollisions = true
+
+	var got []string
+	for _, res := range analysistest.Run(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "key_collisions") {
+		fset := res.Action.Package.Fset
+		for _, d := range res.Action.Diagnostics {
+			if !strings.HasPrefix(d.Message, "duplicate") {
+				continue
+			}
+			if len(d.Related) != 1 {
+				t.Errorf("%s: got %d related positions, want 1", fset.Position(d.Pos), len(d.Related))
+				continue
+			}
+			pos, rel := fset.Position(d.Pos), fset.Position(d.Related[0].Pos)
+			got = append(got, fmt.Sprintf("%d:%d -> %d:%d", pos.Line, pos.Column, rel.Line, rel.Column))
+		}
+	}
+
+	want := []string{
+		"23:42 -> 23:14", // With in the same chain
+		"24:41 -> 24:17", // same call
+		"27:22 -> 26:27", // With assigned to a variable
+		"34:19 -> 33:35", // With before Sugar
+		"36:75 -> 36:53", // same namespace
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("related positions = %q, want %q", got, want)
+	}
+}
+
+func TestAnalyzerKeyRegistry(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.KeyRegistry = "logkeys"
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "key_registry")
+}
+
+func TestAnalyzerKeyTypes(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules.KeyTypes = true
+
+	analysistest.Run(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "key_types/dep", "key_types")
+}
+
+func TestKeyTypesFacts(t *testing.T) {
+	a := analyzer.NewAnalyzer(analyzer.DefaultConfig())
+	if len(a.FactTypes) != 0 {
+		t.Fatalf("FactTypes = %v with key-types off, want none", a.FactTypes)
+	}
+	if err := a.Flags.Set("key-types", "true"); err != nil {
+		t.Fatal(err)
+	}
+	if len(a.FactTypes) != 1 {
+		t.Fatalf("FactTypes = %v after -key-types, want the key kinds fact", a.FactTypes)
+	}
+	if err := a.Flags.Set("key-types", "false"); err != nil {
+		t.Fatal(err)
+	}
+	if len(a.FactTypes) != 0 {
+		t.Fatalf("FactTypes = %v after -key-types=false, want none", a.FactTypes)
+	}
+}
+
+func TestAnalyzerDynamicKey(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules.DynamicKey = true
+
+	analysistest.Run(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "dynamic_key")
+}
+
+func TestAnalyzerSchema(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Schema = filepath.Join(testdataDir(t), "src", "schema", "events.json")
+
+	analysistest.Run(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "schema")
+}
+
+func TestAnalyzerGeneratedCode(t *testing.T) {
+	// code generated from a schema passes every rule, including the schema itself
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules = analyzer.RulesConfig{
+		Lowercase: true, EnglishOnly: true, NoSpecialChars: true, NoSensitive: true,
+		FormatMismatch: true, StaticMessage: true, SprintfMessage: true,
+		KeyStyle: true, KeyCollisions: true, DynamicKey: true,
+	}
+	cfg.Schema = filepath.Join(testdataDir(t), "src", "logevents", "events.json")
+
+	analysistest.Run(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "logevents")
+}
+
+func TestAnalyzerMessageShape(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules = analyzer.RulesConfig{MessageShape: true, ErrorStrings: true}
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "message_shape")
+}
+
+func TestAnalyzerMessageLength(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules = analyzer.RulesConfig{MessageLength: true}
+	cfg.MessageLength = rules.LengthLimits{MaxChars: 40, MaxWords: 6, MinWords: 2}
+	cfg.MessageLengthByLevel = map[string]rules.LengthLimits{
+		"debug": {MaxChars: 80, MaxWords: 12},
+		"error": {MinWords: 3},
+	}
+
+	analysistest.Run(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "message_length")
+}
+
+func TestAnalyzerTerminology(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules = analyzer.RulesConfig{Terminology: true, ErrorStrings: true}
+	cfg.Terminology = map[string]string{
+		"db":           "database",
+		"login":        "sign in",
+		"log in":       "sign in",
+		"successfully": "",
+		"oops":         "",
+		"todo":         "",
+	}
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "terminology")
+}
+
+func TestAnalyzerSpelling(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules = analyzer.RulesConfig{Spelling: true, ErrorStrings: true}
+	cfg.SpellingWords = []string{"golangster"}
+	cfg.SpellingDictionary = filepath.Join(testdataDir(t), "src", "spelling", "dictionary.txt")
+
+	analysistest.RunWithSuggestedFixes(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "spelling")
+}
+
+func TestAnalyzerTemplate(t *testing.T) {
+	cfg := analyzer.DefaultConfig()
+	cfg.Rules = analyzer.RulesConfig{Template: true}
+	cfg.Templates = []rules.Template{
+		{Level: "error", Match: `^(failed to|cannot) `, Explanation: `error messages start with "failed to" or "cannot"`},
+		{Package: "internal/billing/...", NotMatch: `^billing:`, Explanation: "the logger already adds the component"},
+		{Package: "template", Logger: "zap", NotMatch: `(?i)^please `, Explanation: "zap messages are not requests"},
+	}
+
+	analysistest.Run(t, testdataDir(t), analyzer.NewAnalyzer(cfg), "template", "template/internal/billing")
+}
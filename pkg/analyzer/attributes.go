@@ -0,0 +1,245 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
+// slogAttrConstructors is the set of log/slog functions whose first argument is a key.
+var slogAttrConstructors = map[string]bool{
+	"String": true, "Int": true, "Int64": true, "Uint64": true,
+	"Float64": true, "Bool": true, "Time": true, "Duration": true,
+	"Any": true, "Group": true,
+}
+
+// implicitZapKeys maps zap field constructors without a key argument to the key they use.
+var implicitZapKeys = map[string]string{
+	"Error": "error",
+}
+
+// AttrKey is an attribute key found in a logging call.
+type AttrKey struct {
+	// Expr is the key expression, nil for implicit keys such as the "error" of zap.Error.
+	Expr ast.Expr
+	// Name is the key value if the key is a compile-time constant.
+	Name string
+	// Const reports whether the key is a compile-time constant.
+	Const bool
+	// Value is the attribute value expression, nil for groups.
+	Value ast.Expr
+	// Group reports whether the key names a group or namespace.
+	Group bool
+	// Kind is the logger family the key belongs to.
+	Kind LoggerKind
+}
+
+// FindAttrKeys returns the attribute keys passed directly to call: the key of an
+// attribute constructor (slog.Int, zap.String, slog.Group, ...), the keys of
+// key/value arguments of log and With calls, and WithGroup names.
+// Keys of constructors nested in the arguments are returned when those calls are visited.
+func FindAttrKeys(typesInfo *types.Info, call *ast.CallExpr) []AttrKey {
+	var keys []AttrKey
+	if key, ok := attrConstructorKey(typesInfo, call); ok {
+		keys = append(keys, key)
+	}
+	if args, kind, ok := keyValueArgs(typesInfo, call); ok {
+		keys = append(keys, keyValueKeys(typesInfo, args, kind)...)
+	}
+	if kind, method, ok := findWithCall(typesInfo, call); ok {
+		switch {
+		case method == "WithGroup" && len(call.Args) == 1:
+			keys = append(keys, newAttrKey(typesInfo, call.Args[0], nil, kind, true))
+		case method == "WithField" && len(call.Args) == 2:
+			keys = append(keys, newAttrKey(typesInfo, call.Args[0], call.Args[1], kind, false))
+		}
+	}
+	return keys
+}
+
+// FindLoggerName returns the name argument of a call naming a logger: zap
+// Logger.Named, SugaredLogger.Named or logr Logger.WithName.
+func FindLoggerName(typesInfo *types.Info, call *ast.CallExpr) (ast.Expr, bool) {
+	kind, method, ok := findWithCall(typesInfo, call)
+	if !ok || len(call.Args) != 1 {
+		return nil, false
+	}
+	switch {
+	case method == "Named" && (kind == KindZap || kind == KindZapSugared),
+		method == "WithName" && kind == KindLogr:
+		return call.Args[0], true
+	}
+	return nil, false
+}
+
+// FindFieldsKeys returns the keys of a logrus.Fields composite literal.
+func FindFieldsKeys(typesInfo *types.Info, lit *ast.CompositeLit) []AttrKey {
+	named, ok := types.Unalias(typesInfo.TypeOf(lit)).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil ||
+		named.Obj().Pkg().Path() != "github.com/sirupsen/logrus" || named.Obj().Name() != "Fields" {
+		return nil
+	}
+
+	var keys []AttrKey
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			keys = append(keys, newAttrKey(typesInfo, kv.Key, kv.Value, KindLogrus, false))
+		}
+	}
+	return keys
+}
+
+// newAttrKey builds an AttrKey, resolving the key value if it is constant.
+func newAttrKey(typesInfo *types.Info, expr, value ast.Expr, kind LoggerKind, group bool) AttrKey {
+	name, ok := ConstMessage(typesInfo, expr)
+	return AttrKey{Expr: expr, Name: name, Const: ok, Value: value, Group: group, Kind: kind}
+}
+
+// attrConstructorKey reports whether call constructs a single attribute
+// (slog.Attr or zap.Field) and returns its key.
+func attrConstructorKey(typesInfo *types.Info, call *ast.CallExpr) (AttrKey, bool) {
+	fn := calledFunc(typesInfo, call)
+	if fn == nil || fn.Pkg() == nil {
+		return AttrKey{}, false
+	}
+
+	switch fn.Pkg().Path() {
+	case "log/slog":
+		if !slogAttrConstructors[fn.Name()] || len(call.Args) == 0 {
+			return AttrKey{}, false
+		}
+		if fn.Name() == "Group" {
+			return newAttrKey(typesInfo, call.Args[0], nil, KindSlog, true), true
+		}
+		return newAttrKey(typesInfo, call.Args[0], argAt(call.Args, 1), KindSlog, false), true
+
+	case "go.uber.org/zap":
+		if !isZapField(fn.Type().(*types.Signature).Results()) {
+			return AttrKey{}, false
+		}
+		if key, ok := implicitZapKeys[fn.Name()]; ok {
+			return AttrKey{Name: key, Const: true, Value: argAt(call.Args, 0), Kind: KindZap}, true
+		}
+		params := fn.Type().(*types.Signature).Params()
+		if params.Len() == 0 || len(call.Args) == 0 || !isString(params.At(0).Type()) {
+			return AttrKey{}, false
+		}
+		group := fn.Name() == "Namespace"
+		return newAttrKey(typesInfo, call.Args[0], argAt(call.Args, 1), KindZap, group), true
+	}
+	return AttrKey{}, false
+}
+
+// keyValueArgs returns the arguments of call that alternate keys and values:
+// slog log methods, slog.With and Logger.With, slog.Group, sugared zap *w
+// methods and With, and logr Info, Error and WithValues.
+func keyValueArgs(typesInfo *types.Info, call *ast.CallExpr) ([]ast.Expr, LoggerKind, bool) {
+	if logCall, ok := FindLogCall(typesInfo, call); ok {
+		switch {
+		case logCall.Kind == KindSlog && logCall.Method != "LogAttrs",
+			logCall.Kind == KindZapSugared && strings.HasSuffix(logCall.Method, "w"),
+			logCall.Kind == KindLogr:
+			return logCall.Args, logCall.Kind, true
+		}
+		return nil, 0, false
+	}
+
+	if kind, method, ok := findWithCall(typesInfo, call); ok {
+		switch {
+		case kind == KindSlog && method == "With",
+			kind == KindZapSugared && method == "With",
+			kind == KindLogr && method == "WithValues":
+			return call.Args, kind, true
+		}
+		return nil, 0, false
+	}
+
+	if fn := calledFunc(typesInfo, call); fn != nil && fn.Pkg() != nil &&
+		fn.Pkg().Path() == "log/slog" && fn.Name() == "Group" && len(call.Args) > 0 {
+		return call.Args[1:], KindSlog, true
+	}
+	return nil, 0, false
+}
+
+// keyValueKeys returns the keys of alternating key/value arguments.
+// Arguments that already are attributes (slog.Attr, zap.Field) are skipped.
+func keyValueKeys(typesInfo *types.Info, args []ast.Expr, kind LoggerKind) []AttrKey {
+	var keys []AttrKey
+	for i := 0; i < len(args); i++ {
+		if isAttrType(typesInfo.TypeOf(args[i])) {
+			continue
+		}
+		keys = append(keys, newAttrKey(typesInfo, args[i], argAt(args, i+1), kind, false))
+		i++
+	}
+	return keys
+}
+
+// withMethods is the set of methods that derive a logger with attached context.
+var withMethods = map[string]bool{
+	"With": true, "WithGroup": true,
+	"WithValues": true, "WithName": true,
+	"WithField": true, "WithFields": true,
+	"WithError": true, "Named": true,
+}
+
+// findWithCall reports whether call derives a logger through one of withMethods
+// and returns the logger kind and method name.
+func findWithCall(typesInfo *types.Info, call *ast.CallExpr) (LoggerKind, string, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || !withMethods[sel.Sel.Name] {
+		return 0, "", false
+	}
+	kind, ok := loggerReceiverKind(typesInfo, sel.X)
+	return kind, sel.Sel.Name, ok
+}
+
+// calledFunc returns the package-level function or method called by call, if any.
+func calledFunc(typesInfo *types.Info, call *ast.CallExpr) *types.Func {
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.SelectorExpr:
+		fn, _ := typesInfo.Uses[fun.Sel].(*types.Func)
+		return fn
+	case *ast.Ident:
+		fn, _ := typesInfo.Uses[fun].(*types.Func)
+		return fn
+	}
+	return nil
+}
+
+// argAt returns the i-th argument or nil.
+func argAt(args []ast.Expr, i int) ast.Expr {
+	if i < len(args) {
+		return args[i]
+	}
+	return nil
+}
+
+// isAttrType reports whether t is slog.Attr or zap.Field.
+func isAttrType(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+	switch named.Obj().Pkg().Path() {
+	case "log/slog":
+		return named.Obj().Name() == "Attr"
+	case "go.uber.org/zap", "go.uber.org/zap/zapcore":
+		return named.Obj().Name() == "Field"
+	}
+	return false
+}
+
+// isZapField reports whether a result list is a single zap.Field.
+func isZapField(results *types.Tuple) bool {
+	return results.Len() == 1 && isAttrType(results.At(0).Type())
+}
+
+// isString reports whether t is the string type. A nil type is not.
+func isString(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Kind() == types.String
+}
@@ -0,0 +1,117 @@
+package analyzer
+
+import (
+	"go/types"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/idakhno/golangster/pkg/analyzer/rules"
+)
+
+// slogConstructors maps value types to slog.Attr constructors.
+var slogConstructors = map[string]string{
+	"string":        "String",
+	"int":           "Int",
+	"int64":         "Int64",
+	"uint64":        "Uint64",
+	"float64":       "Float64",
+	"bool":          "Bool",
+	"time.Duration": "Duration",
+	"time.Time":     "Time",
+}
+
+// zapConstructors maps value types to zap.Field constructors.
+var zapConstructors = map[string]string{
+	"string":        "String",
+	"int":           "Int",
+	"int64":         "Int64",
+	"uint":          "Uint",
+	"uint64":        "Uint64",
+	"float64":       "Float64",
+	"bool":          "Bool",
+	"time.Duration": "Duration",
+	"time.Time":     "Time",
+}
+
+// attrRenderer returns how attributes are written for the logger of logCall,
+// or nil if the file does not import the package needed to write them.
+// If typed is set, slog attributes use typed constructors such as slog.Int
+// instead of alternating keys and values.
+func attrRenderer(pass *analysis.Pass, logCall LogCall, typed bool) rules.AttrRenderer {
+	pos := logCall.Call.Pos()
+	switch logCall.Kind {
+	case KindZap:
+		zap := importName(pass, pos, "go.uber.org/zap")
+		if zap == "" {
+			return nil
+		}
+		return func(a rules.Attr) string {
+			t := pass.TypesInfo.TypeOf(a.Value)
+			if isErrorType(t) {
+				if a.Key == "err" || a.Key == "error" {
+					return zap + ".Error(" + a.Src + ")"
+				}
+				return zap + ".NamedError(" + strconv.Quote(a.Key) + ", " + a.Src + ")"
+			}
+			return constructorCall(zap, zapConstructors, t, a)
+		}
+
+	case KindSlog:
+		// LogAttrs only accepts slog.Attr values
+		typed = typed || logCall.Method == "LogAttrs"
+		if !typed {
+			break
+		}
+		slog := importName(pass, pos, "log/slog")
+		if slog == "" {
+			if logCall.Method == "LogAttrs" {
+				return nil
+			}
+			break
+		}
+		return func(a rules.Attr) string {
+			return constructorCall(slog, slogConstructors, pass.TypesInfo.TypeOf(a.Value), a)
+		}
+	}
+
+	// slog and logr take alternating keys and values
+	return func(a rules.Attr) string {
+		return strconv.Quote(a.Key) + ", " + a.Src
+	}
+}
+
+// constructorCall writes a typed attribute constructor call for a,
+// falling back to Any when the value type has no dedicated constructor.
+func constructorCall(pkg string, constructors map[string]string, t types.Type, a rules.Attr) string {
+	fn, ok := constructors[typeKey(t)]
+	if !ok {
+		fn = "Any"
+	}
+	return pkg + "." + fn + "(" + strconv.Quote(a.Key) + ", " + a.Src + ")"
+}
+
+// typeKey returns the name of a basic type or the qualified name of a named type,
+// e.g. "int64" or "time.Duration". Untyped constants use their default type.
+func typeKey(t types.Type) string {
+	if t == nil {
+		return ""
+	}
+	switch t := types.Unalias(t).(type) {
+	case *types.Basic:
+		return types.Default(t).(*types.Basic).Name()
+	case *types.Named:
+		if obj := t.Obj(); obj.Pkg() != nil {
+			return obj.Pkg().Path() + "." + obj.Name()
+		}
+	}
+	return ""
+}
+
+// errorType is the predeclared error interface.
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// isErrorType reports whether t implements the error interface.
+func isErrorType(t types.Type) bool {
+	return t != nil && types.Implements(t, errorType)
+}
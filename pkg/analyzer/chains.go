@@ -0,0 +1,184 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/idakhno/golangster/pkg/analyzer/rules"
+)
+
+// localDefs maps local variables assigned exactly once to their value,
+// so that a logger built by a With chain can be followed through variables.
+type localDefs map[types.Object]ast.Expr
+
+// collectLocalDefs records the single assignment of every local variable in files.
+// Variables assigned more than once map to nil.
+func collectLocalDefs(typesInfo *types.Info, files []*ast.File) localDefs {
+	defs := make(localDefs)
+	record := func(id *ast.Ident, value ast.Expr) {
+		obj := typesInfo.ObjectOf(id)
+		if obj == nil || obj.Parent() == nil || obj.Parent() == obj.Pkg().Scope() {
+			return
+		}
+		if _, seen := defs[obj]; seen {
+			value = nil
+		}
+		defs[obj] = value
+	}
+
+	for _, file := range files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.AssignStmt:
+				for i, lhs := range n.Lhs {
+					id, ok := lhs.(*ast.Ident)
+					if !ok {
+						continue
+					}
+					var value ast.Expr
+					if len(n.Lhs) == len(n.Rhs) {
+						value = n.Rhs[i]
+					}
+					record(id, value)
+				}
+			case *ast.ValueSpec:
+				for i, id := range n.Names {
+					var value ast.Expr
+					if len(n.Names) == len(n.Values) {
+						value = n.Values[i]
+					}
+					record(id, value)
+				}
+			}
+			return true
+		})
+	}
+	return defs
+}
+
+// keyCollector gathers the constant attribute keys attached along a logger chain.
+type keyCollector struct {
+	typesInfo *types.Info
+	defs      localDefs
+}
+
+// chainKeys returns the keys attached to the logger expression x by With calls,
+// oldest first, and the group prefix that WithGroup and zap namespaces apply
+// to keys added afterwards.
+func (c keyCollector) chainKeys(x ast.Expr) ([]rules.KeyUse, string) {
+	return c.chain(x, make(map[types.Object]bool))
+}
+
+func (c keyCollector) chain(x ast.Expr, visiting map[types.Object]bool) ([]rules.KeyUse, string) {
+	switch e := ast.Unparen(x).(type) {
+	case *ast.Ident:
+		obj := c.typesInfo.ObjectOf(e)
+		value := c.defs[obj]
+		if value == nil || visiting[obj] {
+			return nil, ""
+		}
+		visiting[obj] = true
+		return c.chain(value, visiting)
+
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return nil, ""
+		}
+		_, method, ok := findWithCall(c.typesInfo, e)
+		if !ok {
+			// zap's Sugar and Desugar keep the attached fields
+			if sel.Sel.Name == "Sugar" || sel.Sel.Name == "Desugar" {
+				return c.chain(sel.X, visiting)
+			}
+			return nil, ""
+		}
+		keys, prefix := c.chain(sel.X, visiting)
+		if method == "WithGroup" {
+			if name, ok := ConstMessage(c.typesInfo, argAt(e.Args, 0)); ok {
+				prefix += name + "."
+			}
+			return keys, prefix
+		}
+		own, prefix := c.callKeys(e, prefix)
+		return append(keys, own...), prefix
+	}
+	return nil, ""
+}
+
+// callKeys returns the keys that a log or With call attaches itself, qualified
+// with prefix, and the prefix in effect after the call's zap namespaces.
+func (c keyCollector) callKeys(call *ast.CallExpr, prefix string) ([]rules.KeyUse, string) {
+	args, ok := attrArgs(c.typesInfo, call)
+	if !ok {
+		return nil, prefix
+	}
+
+	var keys []rules.KeyUse
+	for i := 0; i < len(args); i++ {
+		if isAttrType(c.typesInfo.TypeOf(args[i])) {
+			var attrKeys []rules.KeyUse
+			attrKeys, prefix = c.attrKeys(args[i], prefix)
+			keys = append(keys, attrKeys...)
+			continue
+		}
+		if name, ok := ConstMessage(c.typesInfo, args[i]); ok {
+			keys = append(keys, keyUse(args[i].Pos(), args[i].End(), name, prefix, argAt(args, i+1)))
+		}
+		i++
+	}
+	return keys, prefix
+}
+
+// attrKeys returns the keys of an attribute argument. slog.Group contributes
+// its name and its nested keys, zap.Namespace changes the prefix for later keys.
+func (c keyCollector) attrKeys(expr ast.Expr, prefix string) ([]rules.KeyUse, string) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return nil, prefix
+	}
+	key, ok := attrConstructorKey(c.typesInfo, call)
+	if !ok || !key.Const {
+		return nil, prefix
+	}
+
+	pos, end := call.Pos(), call.End()
+	if key.Expr != nil {
+		pos, end = key.Expr.Pos(), key.Expr.End()
+	}
+	keys := []rules.KeyUse{keyUse(pos, end, key.Name, prefix, key.Value)}
+	if !key.Group {
+		return keys, prefix
+	}
+	keys[0].Group = true
+	if key.Kind == KindZap {
+		// zap.Namespace nests all following fields
+		return keys, prefix + key.Name + "."
+	}
+	nested, _ := c.callKeys(call, prefix+key.Name+".")
+	return append(keys, nested...), prefix
+}
+
+// attrArgs returns the arguments of call that carry attributes: key/value pairs
+// or slog.Attr and zap.Field values.
+func attrArgs(typesInfo *types.Info, call *ast.CallExpr) ([]ast.Expr, bool) {
+	if args, _, ok := keyValueArgs(typesInfo, call); ok {
+		return args, true
+	}
+	if logCall, ok := FindLogCall(typesInfo, call); ok {
+		// zap.Logger methods and slog.LogAttrs take attributes only
+		if logCall.Kind == KindZap || logCall.Method == "LogAttrs" {
+			return logCall.Args, true
+		}
+		return nil, false
+	}
+	if kind, method, ok := findWithCall(typesInfo, call); ok && kind == KindZap && method == "With" {
+		return call.Args, true
+	}
+	return nil, false
+}
+
+func keyUse(pos, end token.Pos, name, prefix string, value ast.Expr) rules.KeyUse {
+	return rules.KeyUse{Pos: pos, End: end, Name: prefix + name, Nested: prefix != "", Value: value}
+}
@@ -1,6 +1,13 @@
 package analyzer
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
 	"github.com/idakhno/golangster/pkg/analyzer/rules"
 )
 
@@ -11,17 +18,150 @@ type Config struct {
 	// SensitiveKeywords is the list of keywords used to detect sensitive data.
 	// If empty, DefaultSensitiveKeywords is used.
 	SensitiveKeywords []string
+	// CaseStyle is the case convention of log messages checked when
+	// Rules.Lowercase is enabled: lowercase, sentence or any.
+	CaseStyle rules.CaseStyle
+	// CaseStyleByPackage overrides CaseStyle for the packages matching a
+	// glob. The first matching entry applies.
+	CaseStyleByPackage []rules.PackageCaseStyle
+	// ProperNouns lists words the lowercase rule accepts capitalized at the
+	// start of a message, such as "Kafka" or "Postgres".
+	ProperNouns []string
+	// EnglishScripts lists the scripts and categories the english rule
+	// allows, see rules.CompileScripts. If empty, rules.DefaultScripts is used.
+	EnglishScripts []string
+	// EnglishScriptsByPackage allows additional scripts in the packages
+	// matching a glob, such as Cyrillic in a localized package. The first
+	// matching entry applies.
+	EnglishScriptsByPackage []rules.PackageScripts
+	// EnglishThreshold is the confidence, between 0 and 1, from which the
+	// english rule reports a Latin-script message as another language, see
+	// RulesConfig.EnglishLanguage. Values above 1 disable the language
+	// scoring. If zero, rules.DefaultLanguageThreshold is used.
+	EnglishThreshold float64
+	// EnglishAllowlist lists words the english rule accepts, such as product
+	// names and proper nouns with diacritics ("Nestlé", "Zürich"). ProperNouns
+	// are accepted as well.
+	EnglishAllowlist []string
+	// SpecialChars configures the forbidden characters, allowed exceptions
+	// and emoji classes of the special-chars rule.
+	SpecialChars rules.CharPolicy
+	// SpecialCharsByLevel overrides SpecialChars per level, see
+	// rules.CharPolicy.Merge. Error strings use SpecialChars.
+	SpecialCharsByLevel map[string]rules.CharPolicy
+	// ErrorStringExemptPrefixes lists prefixes (e.g. "EOF", "HTTP") that exempt
+	// an error string from the lowercase check.
+	ErrorStringExemptPrefixes []string
+	// KeyStyle is the naming convention enforced by the key-style rule.
+	KeyStyle rules.KeyStyle
+	// KeyStyleExceptions lists attribute keys accepted regardless of KeyStyle.
+	KeyStyleExceptions []string
+	// ReservedKeys maps a logger family ("slog", "zap", "logr") to the keys
+	// its handler writes itself. Families missing from the map use
+	// rules.DefaultReservedKeys.
+	ReservedKeys map[string][]string
+	// KeyRegistry is the import path of a package declaring every attribute
+	// key as a constant. If set, string literal keys are reported and replaced
+	// with the matching registry constant.
+	KeyRegistry string
+	// Schema is the path of a JSON or YAML event schema. If set, every structured
+	// log call must match an event declared in the schema, see package schema.
+	Schema string
+	// MessageLength holds the limits of the message-length rule.
+	MessageLength rules.LengthLimits
+	// MessageLengthByLevel overrides MessageLength per level ("debug",
+	// "info", "warn", "error", ...). Zero limits keep the default.
+	MessageLengthByLevel map[string]rules.LengthLimits
+	// Terminology maps banned phrases to their preferred replacement, or to ""
+	// if the phrase must be removed. Phrases match whole words, ignoring case.
+	Terminology map[string]string
+	// SpellingWords lists words the spelling rule accepts in addition to the
+	// embedded English dictionary, such as product names.
+	SpellingWords []string
+	// SpellingDictionary is the path of a project dictionary file with one
+	// or more words per line; lines starting with '#' are comments.
+	SpellingDictionary string
+	// Templates lists the conventions of the template rule, each applying to
+	// the log calls selected by its package glob, logger family and level.
+	Templates []rules.Template
 }
 
 // RulesConfig controls enabling and disabling of individual rules.
 type RulesConfig struct {
+	// Lowercase checks the first letter of log messages against
+	// Config.CaseStyle, and of error strings against lower case.
 	Lowercase      bool
 	EnglishOnly    bool
 	NoSpecialChars bool
 	NoSensitive    bool
+	// FormatMismatch reports printf verbs in methods that do not format
+	// and verb/argument count mismatches in methods that do. It is disabled
+	// by default.
+	FormatMismatch bool
+	// EnglishLanguage makes the english rule score Latin-script messages
+	// against an embedded stop-word and n-gram model and report those that
+	// read as another language. The scoring is a heuristic that loads the
+	// embedded English dictionary, whether or not Spelling is enabled; the
+	// spelling words and dictionary file are not used. It is disabled by
+	// default.
+	EnglishLanguage bool
+	// ErrorStrings applies the enabled message rules to error constructors
+	// such as errors.New and fmt.Errorf. It is disabled by default.
+	ErrorStrings bool
+	// StaticMessage requires constant messages in structured loggers
+	// (slog, zap.Logger, logr). It is disabled by default.
+	StaticMessage bool
+	// SprintfMessage reports structured log messages built with fmt.Sprintf
+	// and suggests typed attributes instead. It is disabled by default.
+	SprintfMessage bool
+	// KeyStyle checks that constant attribute keys follow Config.KeyStyle.
+	// It is disabled by default.
+	KeyStyle bool
+	// KeyCollisions reports reserved attribute keys and keys repeated along
+	// With chains or within a call. It is disabled by default.
+	KeyCollisions bool
+	// KeyTypes reports attribute keys logged with values of different kinds
+	// (string, number, bool, duration) anywhere in the dependency graph.
+	// It is disabled by default.
+	KeyTypes bool
+	// DynamicKey reports attribute keys that are not compile-time constants.
+	// It is disabled by default.
+	DynamicKey bool
+	// MessageShape reports trailing periods and colons, leading and trailing
+	// whitespace, embedded newlines and tabs, and repeated spaces.
+	// It is disabled by default.
+	MessageShape bool
+	// MessageLength checks resolved constant messages against
+	// Config.MessageLength. It is disabled by default.
+	MessageLength bool
+	// Terminology reports the banned phrases of Config.Terminology.
+	// It is disabled by default.
+	Terminology bool
+	// Spelling reports misspelled words using an embedded English dictionary.
+	// It is disabled by default.
+	Spelling bool
+	// Template checks resolved messages against Config.Templates.
+	// It is disabled by default.
+	Template bool
+	// UnicodeSafety reports bidirectional controls, invisible and control
+	// characters and ANSI escape sequences in log messages and attribute
+	// keys. It is disabled by default.
+	UnicodeSafety bool
+	// AttributeKeyText applies the english and special-chars rules to
+	// constant attribute keys, reported under the "attribute-key"
+	// category. It is disabled by default.
+	AttributeKeyText bool
+	// GroupNameText applies the english and special-chars rules to the
+	// names of slog.Group, WithGroup and zap.Namespace, reported under the
+	// "group-name" category. It is disabled by default.
+	GroupNameText bool
+	// LoggerNameText applies the english and special-chars rules to the
+	// names of zap Named and logr WithName, reported under the "logger-name"
+	// category. It is disabled by default.
+	LoggerNameText bool
 }
 
-// DefaultConfig returns a Config with all rules enabled.
+// DefaultConfig returns a Config with all default rules enabled.
 func DefaultConfig() Config {
 	return Config{
 		Rules: RulesConfig{
@@ -31,7 +171,20 @@ func DefaultConfig() Config {
 			NoSensitive:    true,
 		},
 		SensitiveKeywords: rules.DefaultSensitiveKeywords,
+		EnglishScripts:    rules.DefaultScripts,
+		CaseStyle:         rules.LowerCase,
+		KeyStyle:          rules.SnakeCase,
+		MessageLength:     rules.LengthLimits{MaxChars: 200, MaxWords: 30, MinWords: 2},
+		SpecialChars:      rules.CharPolicy{Forbidden: rules.DefaultForbiddenChars},
+	}
+}
+
+// englishThreshold returns the confidence threshold of the english rule.
+func (c *Config) englishThreshold() float64 {
+	if c.EnglishThreshold > 0 {
+		return c.EnglishThreshold
 	}
+	return rules.DefaultLanguageThreshold
 }
 
 // effectiveKeywords returns the keyword list to use for sensitive checks.
@@ -41,3 +194,300 @@ func (c *Config) effectiveKeywords() []string {
 	}
 	return rules.DefaultSensitiveKeywords
 }
+
+// isExemptErrorString reports whether msg starts with an exempt error string prefix.
+func (c *Config) isExemptErrorString(msg string) bool {
+	for _, p := range c.ErrorStringExemptPrefixes {
+		if p != "" && strings.HasPrefix(msg, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// reservedKeys returns the reserved attribute keys for a logger family.
+func (c *Config) reservedKeys(kind LoggerKind) []string {
+	if keys, ok := c.ReservedKeys[kind.String()]; ok {
+		return keys
+	}
+	return rules.DefaultReservedKeys[kind.String()]
+}
+
+// caseStyle returns the case style of log messages in the package with
+// import path pkgPath.
+func (c *Config) caseStyle(pkgPath string) rules.CaseStyle {
+	for _, p := range c.CaseStyleByPackage {
+		if rules.MatchPackage(p.Package, pkgPath) {
+			return p.Style
+		}
+	}
+	if c.CaseStyle == "" {
+		return rules.LowerCase
+	}
+	return c.CaseStyle
+}
+
+// messageLimits returns the message-length limits for a log level.
+func (c *Config) messageLimits(level string) rules.LengthLimits {
+	return c.MessageLength.Merge(c.MessageLengthByLevel[level])
+}
+
+// stringList is a flag.Value holding a comma-separated list of strings.
+type stringList []string
+
+func (l *stringList) String() string {
+	if l == nil {
+		return ""
+	}
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(s string) error {
+	*l = nil
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
+
+// reservedKeysFlag is a flag.Value setting the reserved keys of one logger family.
+type reservedKeysFlag struct {
+	keys   *map[string][]string
+	logger string
+}
+
+func (f reservedKeysFlag) String() string {
+	if f.keys == nil {
+		return ""
+	}
+	return strings.Join((*f.keys)[f.logger], ",")
+}
+
+func (f reservedKeysFlag) Set(s string) error {
+	var list stringList
+	if err := list.Set(s); err != nil {
+		return err
+	}
+	if *f.keys == nil {
+		*f.keys = make(map[string][]string)
+	}
+	(*f.keys)[f.logger] = list
+	return nil
+}
+
+// levelLimitsFlag is a flag.Value setting one message-length limit per level
+// from a list such as "debug=400,info=200".
+type levelLimitsFlag struct {
+	limits *map[string]rules.LengthLimits
+	field  func(*rules.LengthLimits) *int
+}
+
+func (f levelLimitsFlag) String() string {
+	if f.limits == nil {
+		return ""
+	}
+	var parts []string
+	for level, l := range *f.limits {
+		if v := *f.field(&l); v != 0 {
+			parts = append(parts, level+"="+strconv.Itoa(v))
+		}
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func (f levelLimitsFlag) Set(s string) error {
+	var list stringList
+	if err := list.Set(s); err != nil {
+		return err
+	}
+	if *f.limits == nil {
+		*f.limits = make(map[string]rules.LengthLimits)
+	}
+	for _, item := range list {
+		level, value, ok := strings.Cut(item, "=")
+		if !ok {
+			return fmt.Errorf("invalid level limit %q, want level=number", item)
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid level limit %q, want level=number", item)
+		}
+		level = strings.ToLower(strings.TrimSpace(level))
+		l := (*f.limits)[level]
+		*f.field(&l) = n
+		(*f.limits)[level] = l
+	}
+	return nil
+}
+
+// termsFlag is a flag.Value holding a list of phrase=replacement pairs such
+// as "db=database,successfully=". An empty replacement removes the phrase.
+type termsFlag map[string]string
+
+func (f *termsFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	parts := make([]string, 0, len(*f))
+	for phrase, replacement := range *f {
+		parts = append(parts, phrase+"="+replacement)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func (f *termsFlag) Set(s string) error {
+	var list stringList
+	if err := list.Set(s); err != nil {
+		return err
+	}
+	*f = make(termsFlag, len(list))
+	for _, item := range list {
+		phrase, replacement, ok := strings.Cut(item, "=")
+		if !ok || strings.TrimSpace(phrase) == "" {
+			return fmt.Errorf("invalid term %q, want phrase=replacement", item)
+		}
+		(*f)[strings.TrimSpace(phrase)] = strings.TrimSpace(replacement)
+	}
+	return nil
+}
+
+// caseStylesFlag is a flag.Value holding per-package case styles as a list
+// of glob=style pairs such as "internal/legacy/...=sentence,cmd/*=any".
+type caseStylesFlag []rules.PackageCaseStyle
+
+func (f *caseStylesFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	parts := make([]string, 0, len(*f))
+	for _, p := range *f {
+		parts = append(parts, p.Package+"="+string(p.Style))
+	}
+	return strings.Join(parts, ",")
+}
+
+func (f *caseStylesFlag) Set(s string) error {
+	var list stringList
+	if err := list.Set(s); err != nil {
+		return err
+	}
+	*f = nil
+	for _, item := range list {
+		pkg, style, ok := strings.Cut(item, "=")
+		if !ok || strings.TrimSpace(pkg) == "" {
+			return fmt.Errorf("invalid package case style %q, want glob=style", item)
+		}
+		*f = append(*f, rules.PackageCaseStyle{
+			Package: strings.TrimSpace(pkg),
+			Style:   rules.CaseStyle(strings.TrimSpace(style)),
+		})
+	}
+	return nil
+}
+
+// packageScriptsFlag is a flag.Value holding per-package scripts as a list
+// of glob=scripts pairs, the scripts joined by '+', such as
+// "internal/ru/...=Cyrillic,internal/gr=Greek+Cyrillic".
+type packageScriptsFlag []rules.PackageScripts
+
+func (f *packageScriptsFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	parts := make([]string, 0, len(*f))
+	for _, p := range *f {
+		parts = append(parts, p.Package+"="+strings.Join(p.Scripts, "+"))
+	}
+	return strings.Join(parts, ",")
+}
+
+func (f *packageScriptsFlag) Set(s string) error {
+	var list stringList
+	if err := list.Set(s); err != nil {
+		return err
+	}
+	*f = nil
+	for _, item := range list {
+		pkg, scripts, ok := strings.Cut(item, "=")
+		if !ok || strings.TrimSpace(pkg) == "" || strings.TrimSpace(scripts) == "" {
+			return fmt.Errorf("invalid package scripts %q, want glob=script+script", item)
+		}
+		*f = append(*f, rules.PackageScripts{
+			Package: strings.TrimSpace(pkg),
+			Scripts: strings.Split(scripts, "+"),
+		})
+	}
+	return nil
+}
+
+// templatesFlag is a flag.Value holding the templates of the template rule
+// as a JSON array of objects, e.g. [{"level":"error","match":"^failed to "}].
+type templatesFlag []rules.Template
+
+func (f *templatesFlag) String() string {
+	if f == nil || len(*f) == 0 {
+		return ""
+	}
+	data, _ := json.Marshal(*f)
+	return string(data)
+}
+
+func (f *templatesFlag) Set(s string) error {
+	dec := json.NewDecoder(bytes.NewReader([]byte(s)))
+	dec.DisallowUnknownFields()
+	var templates []rules.Template
+	if err := dec.Decode(&templates); err != nil {
+		return fmt.Errorf("invalid templates: %w", err)
+	}
+	*f = templates
+	return nil
+}
+
+// levelCharsFlag is a flag.Value setting one string field of the per-level
+// character policies from "level=characters", such as "debug=?". The
+// characters are taken as they are, commas and spaces included, so the flag
+// is repeated for several levels instead of taking a list.
+type levelCharsFlag struct {
+	policies *map[string]rules.CharPolicy
+	field    func(*rules.CharPolicy) *string
+}
+
+func (f levelCharsFlag) String() string {
+	if f.policies == nil {
+		return ""
+	}
+	var parts []string
+	for level, p := range *f.policies {
+		if v := *f.field(&p); v != "" {
+			parts = append(parts, level+"="+v)
+		}
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func (f levelCharsFlag) Set(s string) error {
+	level, chars, ok := strings.Cut(s, "=")
+	level = strings.ToLower(strings.TrimSpace(level))
+	if !ok || level == "" || chars == "" {
+		return fmt.Errorf("invalid level characters %q, want level=characters", s)
+	}
+	if *f.policies == nil {
+		*f.policies = make(map[string]rules.CharPolicy)
+	}
+	p := (*f.policies)[level]
+	*f.field(&p) = chars
+	(*f.policies)[level] = p
+	return nil
+}
+
+func forbiddenChars(p *rules.CharPolicy) *string { return &p.Forbidden }
+func allowedChars(p *rules.CharPolicy) *string   { return &p.Allowed }
+
+func maxChars(l *rules.LengthLimits) *int { return &l.MaxChars }
+func maxWords(l *rules.LengthLimits) *int { return &l.MaxWords }
+func minWords(l *rules.LengthLimits) *int { return &l.MinWords }
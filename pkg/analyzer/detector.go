@@ -2,47 +2,120 @@ package analyzer
 
 import (
 	"go/ast"
+	"go/constant"
 	"go/token"
 	"go/types"
 	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// LoggerKind identifies the API family of a detected log call.
+type LoggerKind int
+
+const (
+	// KindLog is the standard library log package.
+	KindLog LoggerKind = iota
+	// KindSlog is log/slog, functions and *slog.Logger methods.
+	KindSlog
+	// KindZap is *zap.Logger, which takes typed zap.Field attributes.
+	KindZap
+	// KindZapSugared is *zap.SugaredLogger.
+	KindZapSugared
+	// KindLogr is github.com/go-logr/logr.Logger.
+	KindLogr
+	// KindLogrus is github.com/sirupsen/logrus, functions, *Logger and *Entry methods.
+	KindLogrus
 )
 
-// loggerPackages contains import paths of supported logger packages.
-var loggerPackages = map[string]bool{
-	"log":             true,
-	"log/slog":        true,
-	"go.uber.org/zap": true,
+// Structured reports whether the logger takes a constant message plus attributes.
+func (k LoggerKind) Structured() bool {
+	return k == KindSlog || k == KindZap || k == KindLogr
+}
+
+// String returns the logger family name used in configuration:
+// "log", "slog", "zap", "logr" or "logrus".
+func (k LoggerKind) String() string {
+	switch k {
+	case KindSlog:
+		return "slog"
+	case KindZap, KindZapSugared:
+		return "zap"
+	case KindLogr:
+		return "logr"
+	case KindLogrus:
+		return "logrus"
+	}
+	return "log"
+}
+
+// loggerPackages maps import paths of supported logger packages
+// to the kind of their package-level log functions.
+var loggerPackages = map[string]LoggerKind{
+	"log":             KindLog,
+	"log/slog":        KindSlog,
+	"go.uber.org/zap": KindZap,
+
+	"github.com/sirupsen/logrus": KindLogrus,
 }
 
 // loggerTypes maps package paths to known logger type names.
-var loggerTypes = map[string]map[string]bool{
+var loggerTypes = map[string]map[string]LoggerKind{
+	"log": {
+		"Logger": KindLog,
+	},
 	"log/slog": {
-		"Logger": true,
+		"Logger": KindSlog,
 	},
 	"go.uber.org/zap": {
-		"Logger":        true,
-		"SugaredLogger": true,
+		"Logger":        KindZap,
+		"SugaredLogger": KindZapSugared,
+	},
+	"github.com/go-logr/logr": {
+		"Logger": KindLogr,
+	},
+	"github.com/sirupsen/logrus": {
+		"Logger": KindLogrus,
+		"Entry":  KindLogrus,
 	},
 }
 
 // logMethods is the set of method names considered as log calls.
 var logMethods = map[string]bool{
-	"Info": true, "Infof": true, "Infow": true,
-	"Error": true, "Errorf": true, "Errorw": true,
-	"Debug": true, "Debugf": true, "Debugw": true,
-	"Warn": true, "Warnf": true, "Warnw": true,
-	"Fatal": true, "Fatalf": true, "Fatalw": true,
-	"Panic": true, "Panicf": true, "Panicw": true,
+	"Info": true, "Infof": true, "Infow": true, "Infoln": true,
+	"Error": true, "Errorf": true, "Errorw": true, "Errorln": true,
+	"Debug": true, "Debugf": true, "Debugw": true, "Debugln": true,
+	"Trace": true, "Tracef": true, "Traceln": true,
+	"Warn": true, "Warnf": true, "Warnw": true, "Warnln": true,
+	"Warning": true, "Warningf": true, "Warningln": true,
+	"Fatal": true, "Fatalf": true, "Fatalw": true, "Fatalln": true,
+	"Panic": true, "Panicf": true, "Panicw": true, "Panicln": true,
 	"Print": true, "Printf": true, "Println": true,
-	"Log": true,
+	"Log": true, "Logf": true, "Logw": true, "Logln": true, "LogAttrs": true,
+	"InfoContext": true, "ErrorContext": true,
+	"DebugContext": true, "WarnContext": true,
+}
+
+// logLevelMethods are the log methods that take the level as an argument.
+var logLevelMethods = map[string]bool{
+	"Log": true, "Logf": true, "Logw": true, "Logln": true, "LogAttrs": true,
 }
 
 // LogCall holds information about a detected log call.
 type LogCall struct {
-	// Expr is the AST expression of the first argument (the message).
+	// Call is the log call expression itself.
+	Call *ast.CallExpr
+	// Kind is the API family of the logger.
+	Kind LoggerKind
+	// Method is the name of the called function or method, e.g. "Infof".
+	Method string
+	// Expr is the AST expression of the message argument.
 	Expr ast.Expr
-	// Literals are all string literals found inside the first argument.
+	// Literals are all string literals found inside the message argument.
 	Literals []*ast.BasicLit
+	// Args are the arguments following the message: attributes or format arguments.
+	Args []ast.Expr
 }
 
 // FindLogCall reports whether a CallExpr is a call to a known logger.
@@ -58,45 +131,225 @@ func FindLogCall(typesInfo *types.Info, call *ast.CallExpr) (LogCall, bool) {
 		return LogCall{}, false
 	}
 
-	if len(call.Args) == 0 {
+	kind, ok := loggerReceiverKind(typesInfo, sel.X)
+	if !ok {
+		return LogCall{}, false
+	}
+	// zap.Error is a field constructor that shares its name with a log method
+	if isAttrType(typesInfo.TypeOf(call)) {
 		return LogCall{}, false
 	}
 
-	if !isLoggerReceiver(typesInfo, sel.X) {
+	idx := messageIndex(kind, methodName)
+	if idx >= len(call.Args) {
 		return LogCall{}, false
 	}
 
-	msgExpr := call.Args[0]
+	msgExpr := call.Args[idx]
 	lits := extractStringLiterals(msgExpr)
 
-	return LogCall{Expr: msgExpr, Literals: lits}, true
+	return LogCall{
+		Call:     call,
+		Kind:     kind,
+		Method:   methodName,
+		Expr:     msgExpr,
+		Literals: lits,
+		Args:     call.Args[idx+1:],
+	}, true
+}
+
+// Printf reports whether the log method formats its message like fmt.Sprintf.
+func (c LogCall) Printf() bool {
+	switch c.Kind {
+	case KindLog, KindZapSugared, KindLogrus:
+		return strings.HasSuffix(c.Method, "f")
+	}
+	return false
+}
+
+// levelPrefixes are the method name prefixes that select a log level.
+var levelPrefixes = []struct{ prefix, level string }{
+	{"Trace", "debug"}, {"Debug", "debug"}, {"Info", "info"}, {"Warn", "warn"},
+	{"Error", "error"}, {"Fatal", "fatal"}, {"Panic", "panic"},
+}
+
+// Level returns the level of the log call: "debug", "info", "warn", "error",
+// "fatal" or "panic". Trace methods are reported as "debug". It returns ""
+// for methods without a level, such as log.Print, and for Log calls whose
+// level is not a constant.
+func (c LogCall) Level(typesInfo *types.Info) string {
+	for _, p := range levelPrefixes {
+		if strings.HasPrefix(c.Method, p.prefix) {
+			return p.level
+		}
+	}
+	if !logLevelMethods[c.Method] {
+		return ""
+	}
+
+	var idx int
+	switch c.Kind {
+	case KindSlog:
+		// Log(ctx, level, msg, ...)
+		idx = 1
+	case KindZap, KindZapSugared, KindLogrus:
+		// Log(level, msg, fields...), Log(level, args...), Logf(level, format, args...),
+		// Logw(level, msg, keysAndValues...), Logln(level, args...)
+		idx = 0
+	default:
+		return ""
+	}
+	tv, ok := typesInfo.Types[argAt(c.Call.Args, idx)]
+	if !ok || tv.Value == nil {
+		return ""
+	}
+	level, ok := constant.Int64Val(constant.ToInt(tv.Value))
+	if !ok {
+		return ""
+	}
+	switch c.Kind {
+	case KindZap, KindZapSugared:
+		return zapLevel(level)
+	case KindLogrus:
+		return logrusLevel(level)
+	}
+	return slogLevel(level)
+}
+
+// slogLevel maps a slog.Level value to its level name.
+func slogLevel(level int64) string {
+	switch {
+	case level < 0:
+		return "debug"
+	case level < 4:
+		return "info"
+	case level < 8:
+		return "warn"
+	}
+	return "error"
+}
+
+// zapLevel maps a zapcore.Level value to its level name.
+// DPanic is reported as "panic".
+func zapLevel(level int64) string {
+	switch {
+	case level < 0:
+		return "debug"
+	case level == 0:
+		return "info"
+	case level == 1:
+		return "warn"
+	case level == 2:
+		return "error"
+	case level < 5:
+		return "panic"
+	}
+	return "fatal"
+}
+
+// logrusLevel maps a logrus.Level value to its level name.
+// Trace is reported as "debug".
+func logrusLevel(level int64) string {
+	switch level {
+	case 0:
+		return "panic"
+	case 1:
+		return "fatal"
+	case 2:
+		return "error"
+	case 3:
+		return "warn"
+	case 4:
+		return "info"
+	}
+	return "debug"
+}
+
+// MessageComplete reports whether the message argument is the whole message:
+// the call has no further operands printed after it, only format arguments
+// or attributes.
+func (c LogCall) MessageComplete() bool {
+	if len(c.Args) == 0 || c.Printf() || c.Kind.Structured() {
+		return true
+	}
+	// sugared Infow takes key/value pairs
+	return c.Kind == KindZapSugared && strings.HasSuffix(c.Method, "w")
 }
 
-// isLoggerReceiver reports whether the expression is a known logger receiver.
-func isLoggerReceiver(typesInfo *types.Info, x ast.Expr) bool {
+// ConstMessage returns the value of a constant string expression,
+// such as a literal, a named constant or a concatenation of both.
+func ConstMessage(typesInfo *types.Info, expr ast.Expr) (string, bool) {
+	tv, ok := typesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(tv.Value), true
+}
+
+// messageIndex returns the index of the message argument of a log method.
+func messageIndex(kind LoggerKind, method string) int {
+	switch kind {
+	case KindSlog:
+		// Log(ctx, level, msg, ...), LogAttrs(ctx, level, msg, ...)
+		if method == "Log" || method == "LogAttrs" {
+			return 2
+		}
+		// InfoContext(ctx, msg, ...)
+		if strings.HasSuffix(method, "Context") {
+			return 1
+		}
+	case KindZap:
+		// Log(level, msg, fields...)
+		if method == "Log" {
+			return 1
+		}
+	case KindZapSugared:
+		// Log(level, args...), Logf(level, template, args...),
+		// Logw(level, msg, keysAndValues...), Logln(level, args...)
+		if method == "Log" || method == "Logf" || method == "Logw" || method == "Logln" {
+			return 1
+		}
+	case KindLogr:
+		// Error(err, msg, keysAndValues...)
+		if method == "Error" {
+			return 1
+		}
+	case KindLogrus:
+		// Log(level, args...), Logf(level, format, args...), Logln(level, args...)
+		if method == "Log" || method == "Logf" || method == "Logln" {
+			return 1
+		}
+	}
+	return 0
+}
+
+// loggerReceiverKind reports whether the expression is a known logger receiver
+// and returns the kind of the logger.
+func loggerReceiverKind(typesInfo *types.Info, x ast.Expr) (LoggerKind, bool) {
 	switch v := x.(type) {
 	case *ast.Ident:
 		obj := typesInfo.ObjectOf(v)
 		if obj == nil {
-			return false
+			return 0, false
 		}
 		// package-level call: slog.Info(...), log.Print(...)
 		if pkgName, ok := obj.(*types.PkgName); ok {
-			return loggerPackages[pkgName.Imported().Path()]
+			kind, ok := loggerPackages[pkgName.Imported().Path()]
+			return kind, ok
 		}
 		// method call on a variable: logger.Info(...)
-		return isLoggerType(typesInfo.TypeOf(v))
+		return loggerTypeKind(typesInfo.TypeOf(v))
 
 	default:
 		// chained call or other expression - check the type of the left side
-		return isLoggerType(typesInfo.TypeOf(x))
+		return loggerTypeKind(typesInfo.TypeOf(x))
 	}
 }
 
-// isLoggerType reports whether a type is a known logger type.
-func isLoggerType(t types.Type) bool {
+// loggerTypeKind reports whether a type is a known logger type and returns its kind.
+func loggerTypeKind(t types.Type) (LoggerKind, bool) {
 	if t == nil {
-		return false
+		return 0, false
 	}
 	// dereference pointer if needed
 	if ptr, ok := t.(*types.Pointer); ok {
@@ -104,20 +357,21 @@ func isLoggerType(t types.Type) bool {
 	}
 	named, ok := t.(*types.Named)
 	if !ok {
-		return false
+		return 0, false
 	}
 	obj := named.Obj()
 	if obj.Pkg() == nil {
-		return false
+		return 0, false
 	}
 	pkgPath := obj.Pkg().Path()
 	typeName := obj.Name()
 
 	types, exists := loggerTypes[pkgPath]
 	if !exists {
-		return false
+		return 0, false
 	}
-	return types[typeName]
+	kind, ok := types[typeName]
+	return kind, ok
 }
 
 // extractStringLiterals recursively collects all string literals from an expression.
@@ -141,6 +395,29 @@ func extractStringLiterals(expr ast.Expr) []*ast.BasicLit {
 	return nil
 }
 
+// edgeLiterals returns the string literals at the start and the end of a
+// message expression, nil where the message starts or ends with another operand.
+func edgeLiterals(expr ast.Expr) (first, last *ast.BasicLit) {
+	leftmost, rightmost := expr, expr
+	for {
+		e, ok := ast.Unparen(leftmost).(*ast.BinaryExpr)
+		if !ok || e.Op != token.ADD {
+			break
+		}
+		leftmost = e.X
+	}
+	for {
+		e, ok := ast.Unparen(rightmost).(*ast.BinaryExpr)
+		if !ok || e.Op != token.ADD {
+			break
+		}
+		rightmost = e.Y
+	}
+	first, _ = ast.Unparen(leftmost).(*ast.BasicLit)
+	last, _ = ast.Unparen(rightmost).(*ast.BasicLit)
+	return first, last
+}
+
 // UnquoteStringLit returns the unquoted string value of a basic literal.
 func UnquoteStringLit(lit *ast.BasicLit) (string, bool) {
 	val, err := strconv.Unquote(lit.Value)
@@ -149,3 +426,58 @@ func UnquoteStringLit(lit *ast.BasicLit) (string, bool) {
 	}
 	return val, true
 }
+
+// importName returns the name under which the file containing pos imports path,
+// or "" if the file does not import it by a usable name.
+func importName(pass *analysis.Pass, pos token.Pos, path string) string {
+	file := fileOf(pass, pos)
+	if file == nil {
+		return ""
+	}
+	for _, spec := range file.Imports {
+		if p, err := strconv.Unquote(spec.Path.Value); err != nil || p != path {
+			continue
+		}
+		if spec.Name != nil {
+			if spec.Name.Name == "_" || spec.Name.Name == "." {
+				return ""
+			}
+			return spec.Name.Name
+		}
+		if pkg := importedPackage(pass, path); pkg != nil {
+			return pkg.Name()
+		}
+		return path[strings.LastIndex(path, "/")+1:]
+	}
+	return ""
+}
+
+// fileOf returns the file of the analyzed package that contains pos.
+func fileOf(pass *analysis.Pass, pos token.Pos) *ast.File {
+	for _, file := range pass.Files {
+		if file.FileStart <= pos && pos < file.FileEnd {
+			return file
+		}
+	}
+	return nil
+}
+
+// importedPackage returns the package imported by the analyzed package under path,
+// directly or through its dependencies.
+func importedPackage(pass *analysis.Pass, path string) *types.Package {
+	seen := make(map[*types.Package]bool)
+	queue := pass.Pkg.Imports()
+	for len(queue) > 0 {
+		pkg := queue[0]
+		queue = queue[1:]
+		if seen[pkg] {
+			continue
+		}
+		seen[pkg] = true
+		if pkg.Path() == path {
+			return pkg
+		}
+		queue = append(queue, pkg.Imports()...)
+	}
+	return nil
+}
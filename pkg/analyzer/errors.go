@@ -0,0 +1,67 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
+// errorConstructors maps package paths to error constructor functions
+// and the index of their message argument.
+var errorConstructors = map[string]map[string]int{
+	"errors": {
+		"New": 0,
+	},
+	"fmt": {
+		"Errorf": 0,
+	},
+	"github.com/pkg/errors": {
+		"New": 0, "Errorf": 0,
+		"Wrap": 1, "Wrapf": 1,
+		"WithMessage": 1, "WithMessagef": 1,
+	},
+	"google.golang.org/grpc/status": {
+		"Error": 1, "Errorf": 1,
+		"New": 1, "Newf": 1,
+	},
+}
+
+// ErrorCall holds information about a detected error constructor call.
+type ErrorCall struct {
+	// Expr is the AST expression of the message argument.
+	Expr ast.Expr
+	// Literals are all string literals found inside the message argument.
+	Literals []*ast.BasicLit
+	// Format reports whether the message is a printf-style format string.
+	Format bool
+}
+
+// FindErrorCall reports whether a CallExpr is a call to a known error constructor.
+// If so, it returns an ErrorCall with the message expression and its string literals.
+func FindErrorCall(typesInfo *types.Info, call *ast.CallExpr) (ErrorCall, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return ErrorCall{}, false
+	}
+
+	fn, ok := typesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return ErrorCall{}, false
+	}
+	// methods never construct errors, only package-level functions do
+	if sig, ok := fn.Type().(*types.Signature); !ok || sig.Recv() != nil {
+		return ErrorCall{}, false
+	}
+
+	idx, ok := errorConstructors[fn.Pkg().Path()][fn.Name()]
+	if !ok || idx >= len(call.Args) {
+		return ErrorCall{}, false
+	}
+
+	msgExpr := call.Args[idx]
+	return ErrorCall{
+		Expr:     msgExpr,
+		Literals: extractStringLiterals(msgExpr),
+		Format:   strings.HasSuffix(fn.Name(), "f"),
+	}, true
+}
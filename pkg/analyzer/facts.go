@@ -0,0 +1,186 @@
+package analyzer
+
+import (
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/idakhno/golangster/pkg/analyzer/rules"
+)
+
+// keyKindsFact records, for a package, the value kind of every constant
+// attribute key it logs and where the key was first logged with that kind.
+type keyKindsFact struct {
+	Keys map[string]keyKindSite
+}
+
+// keyKindSite is a serializable KeySite.
+type keyKindSite struct {
+	Kind     string
+	Filename string
+	Line     int
+	Column   int
+}
+
+func (*keyKindsFact) AFact() {}
+
+func (f *keyKindsFact) String() string {
+	keys := make([]string, 0, len(f.Keys))
+	for k := range f.Keys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		keys[i] = k + ":" + f.Keys[k].Kind
+	}
+	return "keyKinds(" + strings.Join(keys, ", ") + ")"
+}
+
+// setKeyTypeFacts registers keyKindsFact with a only if the key-types rule is
+// on: an analyzer with facts makes the driver analyze every dependency of the
+// checked packages, the standard library included.
+func setKeyTypeFacts(a *analysis.Analyzer, on bool) {
+	if on {
+		a.FactTypes = []analysis.Fact{new(keyKindsFact)}
+	} else {
+		a.FactTypes = nil
+	}
+}
+
+// keyTypesFlag is the -key-types flag. The drivers look at FactTypes after
+// parsing the flags, so setting it registers the fact type as the
+// configuration does.
+type keyTypesFlag struct {
+	a       *analysis.Analyzer
+	enabled *bool
+}
+
+func (f *keyTypesFlag) IsBoolFlag() bool { return true }
+
+func (f *keyTypesFlag) String() string {
+	if f == nil || f.enabled == nil {
+		return "false"
+	}
+	return strconv.FormatBool(*f.enabled)
+}
+
+func (f *keyTypesFlag) Set(s string) error {
+	on, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	*f.enabled = on
+	setKeyTypeFacts(f.a, on)
+	return nil
+}
+
+// depKeySite is a key site recorded in the fact of a dependency.
+type depKeySite struct {
+	pkgPath string
+	site    keyKindSite
+}
+
+// keyTypeChecker compares the value kinds of attribute keys logged in a package
+// with each other and with the kinds recorded by its dependencies.
+type keyTypeChecker struct {
+	pass  *analysis.Pass
+	deps  map[string]depKeySite
+	local map[string]rules.KeySite
+	fact  *keyKindsFact
+}
+
+func newKeyTypeChecker(pass *analysis.Pass) *keyTypeChecker {
+	c := &keyTypeChecker{
+		pass:  pass,
+		deps:  make(map[string]depKeySite),
+		local: make(map[string]rules.KeySite),
+		fact:  &keyKindsFact{Keys: make(map[string]keyKindSite)},
+	}
+
+	facts := pass.AllPackageFacts()
+	// sort for deterministic reports when several dependencies disagree
+	sort.Slice(facts, func(i, j int) bool {
+		return facts[i].Package.Path() < facts[j].Package.Path()
+	})
+	for _, pf := range facts {
+		fact, ok := pf.Fact.(*keyKindsFact)
+		if !ok {
+			continue
+		}
+		for key, site := range fact.Keys {
+			if _, seen := c.deps[key]; !seen {
+				c.deps[key] = depKeySite{pkgPath: pf.Package.Path(), site: site}
+			}
+		}
+	}
+	return c
+}
+
+// check records the value kind of key and reports a conflict with the first
+// site of the key in the package or, failing that, with a dependency. Every
+// site is compared with the dependencies, not only the first one, so that
+// each call logging a kind the dependencies disagree with is reported.
+func (c *keyTypeChecker) check(key AttrKey) {
+	if !key.Const || key.Group || key.Value == nil {
+		return
+	}
+	kind := rules.ValueKind(c.pass.TypesInfo.TypeOf(key.Value))
+	if kind == "" {
+		return
+	}
+	node := key.Value
+	if key.Expr != nil {
+		node = key.Expr
+	}
+
+	if first, ok := c.local[key.Name]; !ok {
+		posn := c.pass.Fset.Position(node.Pos())
+		c.local[key.Name] = rules.KeySite{
+			Kind:  kind,
+			Pos:   node.Pos(),
+			End:   node.End(),
+			Where: "at " + filepath.Base(posn.Filename) + ":" + strconv.Itoa(posn.Line),
+		}
+		c.fact.Keys[key.Name] = keyKindSite{Kind: kind, Filename: posn.Filename, Line: posn.Line, Column: posn.Column}
+	} else if first.Kind != kind {
+		rules.ReportKeyTypeConflict(c.pass, node, key.Name, kind, first)
+		return
+	}
+
+	if dep, ok := c.deps[key.Name]; ok && dep.site.Kind != kind {
+		pos := lookupPos(c.pass.Fset, dep.site)
+		rules.ReportKeyTypeConflict(c.pass, node, key.Name, kind, rules.KeySite{
+			Kind:  dep.site.Kind,
+			Pos:   pos,
+			End:   pos,
+			Where: "in package " + dep.pkgPath,
+		})
+	}
+}
+
+// export publishes the key kinds of the package for its dependents.
+func (c *keyTypeChecker) export() {
+	if len(c.fact.Keys) > 0 {
+		c.pass.ExportPackageFact(c.fact)
+	}
+}
+
+// lookupPos maps a recorded site back to a position if its file is part of fset,
+// which is the case when dependencies are loaded from source.
+func lookupPos(fset *token.FileSet, site keyKindSite) token.Pos {
+	pos := token.NoPos
+	fset.Iterate(func(f *token.File) bool {
+		if f.Name() != site.Filename {
+			return true
+		}
+		if site.Line >= 1 && site.Line <= f.LineCount() {
+			pos = f.LineStart(site.Line) + token.Pos(site.Column-1)
+		}
+		return false
+	})
+	return pos
+}
@@ -0,0 +1,86 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/idakhno/golangster/pkg/analyzer/rules"
+)
+
+// formatCall describes logCall for the format-mismatch rule.
+// It reports false if the message is not constant or the arguments are spread with "...".
+func formatCall(typesInfo *types.Info, logCall LogCall) (rules.FormatCall, bool) {
+	format, ok := ConstMessage(typesInfo, logCall.Expr)
+	if !ok || logCall.Call.Ellipsis.IsValid() {
+		return rules.FormatCall{}, false
+	}
+	return rules.FormatCall{
+		Method:  logCall.Call.Fun.(*ast.SelectorExpr).Sel,
+		Msg:     logCall.Expr,
+		Format:  format,
+		Args:    logCall.Args,
+		Printf:  logCall.Printf(),
+		Sibling: formatSibling(typesInfo, logCall),
+	}, true
+}
+
+// formatSibling returns the method with the opposite formatting behaviour,
+// e.g. Printf for Print, or "" if the logger has no such method.
+func formatSibling(typesInfo *types.Info, logCall LogCall) string {
+	m := logCall.Method
+	switch logCall.Kind {
+	case KindLog, KindLogrus:
+		if logCall.Printf() {
+			return strings.TrimSuffix(m, "f")
+		}
+		return strings.TrimSuffix(m, "ln") + "f"
+
+	case KindZapSugared:
+		base := m
+		if strings.HasSuffix(m, "f") || strings.HasSuffix(m, "w") {
+			base = m[:len(m)-1]
+		}
+		if !logCall.Printf() {
+			return base + "f"
+		}
+		if isKeyValues(typesInfo, logCall.Args) {
+			return base + "w"
+		}
+		return base
+	}
+	return ""
+}
+
+// isKeyValues reports whether args look like alternating constant string keys and values.
+func isKeyValues(typesInfo *types.Info, args []ast.Expr) bool {
+	if len(args) == 0 || len(args)%2 != 0 {
+		return false
+	}
+	for i := 0; i < len(args); i += 2 {
+		if _, ok := ConstMessage(typesInfo, args[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// resolvedMessage returns the constant value of the message of logCall and
+// whether it is a printf format: the message of a printf-style method or the
+// format of a fmt.Sprintf message. Messages followed by further printed
+// operands, as in log.Println("failed:", err), are not resolved.
+func resolvedMessage(pass *analysis.Pass, logCall LogCall) (string, bool, bool) {
+	if !logCall.MessageComplete() {
+		return "", false, false
+	}
+	if msg, ok := ConstMessage(pass.TypesInfo, logCall.Expr); ok {
+		return msg, logCall.Printf(), true
+	}
+	if rules.IsSprintfCall(pass, logCall.Expr) {
+		format, ok := ConstMessage(pass.TypesInfo, ast.Unparen(logCall.Expr).(*ast.CallExpr).Args[0])
+		return format, true, ok
+	}
+	return "", false, false
+}
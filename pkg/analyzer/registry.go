@@ -0,0 +1,37 @@
+package analyzer
+
+import (
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/idakhno/golangster/pkg/analyzer/rules"
+)
+
+// loadRegistry collects the exported string constants of the attribute key
+// registry package at path, as seen from the analyzed package.
+func loadRegistry(pass *analysis.Pass, path string) *rules.Registry {
+	reg := &rules.Registry{Path: path, Name: path[strings.LastIndex(path, "/")+1:]}
+	pkg := importedPackage(pass, path)
+	if pkg == nil {
+		return reg
+	}
+
+	reg.Name = pkg.Name()
+	reg.Loaded = true
+	reg.Consts = make(map[string]string)
+	scope := pkg.Scope()
+	// Names is sorted, so the first constant wins if several share a value
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !c.Exported() || c.Val().Kind() != constant.String {
+			continue
+		}
+		if v := constant.StringVal(c.Val()); reg.Consts[v] == "" {
+			reg.Consts[v] = name
+		}
+	}
+	return reg
+}
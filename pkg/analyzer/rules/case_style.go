@@ -0,0 +1,84 @@
+package rules
+
+import (
+	"go/ast"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// CaseStyle is the case convention of the first letter of a message.
+type CaseStyle string
+
+const (
+	// LowerCase messages look like "starting server".
+	LowerCase CaseStyle = "lowercase"
+	// SentenceCase messages look like "Starting server".
+	SentenceCase CaseStyle = "sentence"
+	// AnyCase accepts both.
+	AnyCase CaseStyle = "any"
+)
+
+// Valid reports whether s is a known case style.
+func (s CaseStyle) Valid() bool {
+	switch s {
+	case LowerCase, SentenceCase, AnyCase:
+		return true
+	}
+	return false
+}
+
+// PackageCaseStyle selects the case style of the packages matching a glob,
+// see MatchPackage.
+type PackageCaseStyle struct {
+	Package string
+	Style   CaseStyle
+}
+
+// CheckCaseStyle reports if the first word of msg, starting at byte offset
+// off, does not follow style. The SuggestedFix lowercases the whole word for
+// LowerCase and capitalizes its first letter for SentenceCase.
+//
+// Acronyms such as "HTTP" and mixed-case Go identifiers such as "NewServer"
+// or "userID" are left alone in both directions, as are properNouns and
+// names declared in the package.
+func CheckCaseStyle(pass *analysis.Pass, subject Subject, msg string, lit *ast.BasicLit, off int, style CaseStyle, properNouns []string) {
+	word := firstWord(msg[off:])
+	r, size := utf8.DecodeRuneInString(word)
+
+	var message, fixMessage string
+	var conv func(rune) rune
+	fixEnd := off + len(word)
+	switch {
+	case style == LowerCase && unicode.IsUpper(r):
+		if capitalAllowed(word, properNouns, pass.Pkg) {
+			return
+		}
+		message = "must start with a lowercase letter"
+		fixMessage = "convert first word to lowercase"
+		conv = unicode.ToLower
+
+	case style == SentenceCase && unicode.IsLower(r):
+		if isMixedCase(word) || pass.Pkg != nil && pass.Pkg.Scope().Lookup(word) != nil {
+			return
+		}
+		message = "must start with an uppercase letter"
+		fixMessage = "capitalize first letter"
+		fixEnd = off + size
+		conv = unicode.ToUpper
+
+	default:
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:     litPos(lit, off),
+		End:     litPos(lit, off+len(word)),
+		Message: string(subject) + " " + message,
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message:   fixMessage,
+			TextEdits: runeEdits(lit, msg, off, fixEnd, conv),
+		}},
+	})
+}
@@ -0,0 +1,16 @@
+package rules
+
+import "testing"
+
+func TestCaseStyleValid(t *testing.T) {
+	for _, s := range []CaseStyle{LowerCase, SentenceCase, AnyCase} {
+		if !s.Valid() {
+			t.Errorf("%q.Valid() = false", s)
+		}
+	}
+	for _, s := range []CaseStyle{"", "upper", "Sentence"} {
+		if s.Valid() {
+			t.Errorf("%q.Valid() = true", s)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// DefaultForbiddenChars are the special characters reported by default.
+const DefaultForbiddenChars = "!?"
+
+// CharPolicy configures the special-chars rule.
+type CharPolicy struct {
+	// Forbidden lists the characters reported as special characters.
+	// If empty, DefaultForbiddenChars is used.
+	Forbidden string
+	// Allowed lists characters accepted even if they are forbidden or
+	// emoji, such as "→" or "°".
+	Allowed string
+	// Emoji lists the character classes reported as emoji: code point
+	// ranges such as "U+1F300-U+1F9FF" or "U+2705", Unicode category,
+	// script or property names such as "So", and "Extended_Pictographic".
+	// If empty, a built-in set of emoji blocks is used.
+	Emoji []string
+}
+
+// Merge returns p with the settings of o applied on top: Forbidden and
+// Emoji are replaced if o sets them, the characters of o.Allowed are
+// allowed in addition to those of p.
+func (p CharPolicy) Merge(o CharPolicy) CharPolicy {
+	if o.Forbidden != "" {
+		p.Forbidden = o.Forbidden
+	}
+	if len(o.Emoji) > 0 {
+		p.Emoji = o.Emoji
+	}
+	p.Allowed += o.Allowed
+	return p
+}
+
+// CharSet is a compiled CharPolicy.
+type CharSet struct {
+	forbidden string
+	allowed   string
+	emoji     []*unicode.RangeTable
+}
+
+// DefaultCharSet is the CharSet of the zero CharPolicy.
+var DefaultCharSet = &CharSet{forbidden: DefaultForbiddenChars, emoji: []*unicode.RangeTable{emojiRanges}}
+
+// Compile parses the emoji classes of p.
+func (p CharPolicy) Compile() (*CharSet, error) {
+	set := &CharSet{forbidden: p.Forbidden, allowed: p.Allowed}
+	if set.forbidden == "" {
+		set.forbidden = DefaultForbiddenChars
+	}
+	for _, spec := range p.Emoji {
+		table, err := parseCharClass(strings.TrimSpace(spec))
+		if err != nil {
+			return nil, err
+		}
+		set.emoji = append(set.emoji, table)
+	}
+	if len(set.emoji) == 0 {
+		set.emoji = DefaultCharSet.emoji
+	}
+	return set, nil
+}
+
+// Forbidden reports whether r is a forbidden special character.
+func (s *CharSet) Forbidden(r rune) bool {
+	return strings.ContainsRune(s.forbidden, r) && !strings.ContainsRune(s.allowed, r)
+}
+
+// Emoji reports whether r is an emoji.
+func (s *CharSet) Emoji(r rune) bool {
+	if strings.ContainsRune(s.allowed, r) {
+		return false
+	}
+	for _, t := range s.emoji {
+		if unicode.Is(t, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCharClass returns the range table of a code point range, a Unicode
+// category, script or property name, or "Extended_Pictographic".
+func parseCharClass(spec string) (*unicode.RangeTable, error) {
+	if spec == "Extended_Pictographic" {
+		return extendedPictographic, nil
+	}
+	for _, tables := range []map[string]*unicode.RangeTable{unicode.Categories, unicode.Scripts, unicode.Properties} {
+		if t, ok := tables[spec]; ok {
+			return t, nil
+		}
+	}
+	if strings.HasPrefix(spec, "U+") {
+		lo, hi, ok := strings.Cut(spec, "-")
+		if !ok {
+			hi = lo
+		}
+		from, err1 := parseCodePoint(lo)
+		to, err2 := parseCodePoint(hi)
+		if err1 == nil && err2 == nil && from <= to {
+			return &unicode.RangeTable{R32: []unicode.Range32{{Lo: from, Hi: to, Stride: 1}}}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown character class %q, want U+XXXX, U+XXXX-U+YYYY or a Unicode category, script or property", spec)
+}
+
+// parseCodePoint parses a code point written as "U+1F600".
+func parseCodePoint(s string) (uint32, error) {
+	hex, ok := strings.CutPrefix(strings.TrimSpace(s), "U+")
+	if !ok {
+		return 0, fmt.Errorf("invalid code point %q", s)
+	}
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || n > unicode.MaxRune {
+		return 0, fmt.Errorf("invalid code point %q", s)
+	}
+	return uint32(n), nil
+}
+
+// extendedPictographic holds the Extended_Pictographic property of the
+// Unicode emoji data, which the unicode package does not provide.
+var extendedPictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00A9, 0x00A9, 1},
+		{0x00AE, 0x00AE, 1},
+		{0x203C, 0x203C, 1},
+		{0x2049, 0x2049, 1},
+		{0x2122, 0x2122, 1},
+		{0x2139, 0x2139, 1},
+		{0x2194, 0x2199, 1},
+		{0x21A9, 0x21AA, 1},
+		{0x231A, 0x231B, 1},
+		{0x2328, 0x2328, 1},
+		{0x2388, 0x2388, 1},
+		{0x23CF, 0x23CF, 1},
+		{0x23E9, 0x23F3, 1},
+		{0x23F8, 0x23FA, 1},
+		{0x24C2, 0x24C2, 1},
+		{0x25AA, 0x25AB, 1},
+		{0x25B6, 0x25B6, 1},
+		{0x25C0, 0x25C0, 1},
+		{0x25FB, 0x25FE, 1},
+		{0x2600, 0x2605, 1},
+		{0x2607, 0x2612, 1},
+		{0x2614, 0x2685, 1},
+		{0x2690, 0x2705, 1},
+		{0x2708, 0x2712, 1},
+		{0x2714, 0x2714, 1},
+		{0x2716, 0x2716, 1},
+		{0x271D, 0x271D, 1},
+		{0x2721, 0x2721, 1},
+		{0x2728, 0x2728, 1},
+		{0x2733, 0x2734, 1},
+		{0x2744, 0x2744, 1},
+		{0x2747, 0x2747, 1},
+		{0x274C, 0x274C, 1},
+		{0x274E, 0x274E, 1},
+		{0x2753, 0x2755, 1},
+		{0x2757, 0x2757, 1},
+		{0x2763, 0x2767, 1},
+		{0x2795, 0x2797, 1},
+		{0x27A1, 0x27A1, 1},
+		{0x27B0, 0x27B0, 1},
+		{0x27BF, 0x27BF, 1},
+		{0x2934, 0x2935, 1},
+		{0x2B05, 0x2B07, 1},
+		{0x2B1B, 0x2B1C, 1},
+		{0x2B50, 0x2B50, 1},
+		{0x2B55, 0x2B55, 1},
+		{0x3030, 0x3030, 1},
+		{0x303D, 0x303D, 1},
+		{0x3297, 0x3297, 1},
+		{0x3299, 0x3299, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1F000, 0x1F0FF, 1},
+		{0x1F10D, 0x1F10F, 1},
+		{0x1F12F, 0x1F12F, 1},
+		{0x1F16C, 0x1F171, 1},
+		{0x1F17E, 0x1F17F, 1},
+		{0x1F18E, 0x1F18E, 1},
+		{0x1F191, 0x1F19A, 1},
+		{0x1F1AD, 0x1F1E5, 1},
+		{0x1F201, 0x1F20F, 1},
+		{0x1F21A, 0x1F21A, 1},
+		{0x1F22F, 0x1F22F, 1},
+		{0x1F232, 0x1F23A, 1},
+		{0x1F23C, 0x1F23F, 1},
+		{0x1F249, 0x1F3FA, 1},
+		{0x1F400, 0x1F53D, 1},
+		{0x1F546, 0x1F64F, 1},
+		{0x1F680, 0x1F6FF, 1},
+		{0x1F774, 0x1F77F, 1},
+		{0x1F7D5, 0x1F7FF, 1},
+		{0x1F80C, 0x1F80F, 1},
+		{0x1F848, 0x1F84F, 1},
+		{0x1F85A, 0x1F85F, 1},
+		{0x1F888, 0x1F88F, 1},
+		{0x1F8AE, 0x1F8FF, 1},
+		{0x1F90C, 0x1F93A, 1},
+		{0x1F93C, 0x1F945, 1},
+		{0x1F947, 0x1FAFF, 1},
+		{0x1FC00, 0x1FFFD, 1},
+	},
+	LatinOffset: 2,
+}
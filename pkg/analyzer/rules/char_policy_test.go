@@ -0,0 +1,71 @@
+package rules
+
+import "testing"
+
+func TestCharPolicyCompile(t *testing.T) {
+	tests := []struct {
+		policy  CharPolicy
+		wantErr bool
+	}{
+		{CharPolicy{}, false},
+		{CharPolicy{Emoji: []string{"U+1F300-U+1F5FF", "U+2705"}}, false},
+		{CharPolicy{Emoji: []string{"So", "Extended_Pictographic", "Han"}}, false},
+		{CharPolicy{Emoji: []string{"Emoji"}}, true},
+		{CharPolicy{Emoji: []string{"U+1F5FF-U+1F300"}}, true},
+		{CharPolicy{Emoji: []string{"U+ZZ"}}, true},
+		{CharPolicy{Emoji: []string{"U+110000"}}, true},
+	}
+	for _, tc := range tests {
+		_, err := tc.policy.Compile()
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%+v.Compile() error = %v, want error %v", tc.policy, err, tc.wantErr)
+		}
+	}
+}
+
+func TestCharSet(t *testing.T) {
+	set, err := CharPolicy{
+		Forbidden: "!?\"",
+		Allowed:   "?→°",
+		Emoji:     []string{"Extended_Pictographic"},
+	}.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		r                rune
+		forbidden, emoji bool
+	}{
+		{'!', true, false},
+		{'"', true, false},
+		{'?', false, false},
+		{'.', false, false},
+		{'→', false, false}, // right arrow, allowed
+		{'↔', false, true},  // left right arrow
+		{'°', false, false}, // degree sign
+		{'©', false, true},  // copyright sign
+		{'\U0001F680', false, true},
+		{'a', false, false},
+	}
+	for _, tc := range tests {
+		if got := set.Forbidden(tc.r); got != tc.forbidden {
+			t.Errorf("Forbidden(%U) = %v, want %v", tc.r, got, tc.forbidden)
+		}
+		if got := set.Emoji(tc.r); got != tc.emoji {
+			t.Errorf("Emoji(%U) = %v, want %v", tc.r, got, tc.emoji)
+		}
+	}
+}
+
+func TestCharPolicyMerge(t *testing.T) {
+	base := CharPolicy{Forbidden: "!?", Allowed: "→", Emoji: []string{"So"}}
+	got := base.Merge(CharPolicy{Allowed: "?"})
+	want := CharPolicy{Forbidden: "!?", Allowed: "→?", Emoji: []string{"So"}}
+	if got.Forbidden != want.Forbidden || got.Allowed != want.Allowed || len(got.Emoji) != 1 {
+		t.Errorf("Merge = %+v, want %+v", got, want)
+	}
+	got = base.Merge(CharPolicy{Forbidden: "!", Emoji: []string{"U+2705"}})
+	if got.Forbidden != "!" || got.Emoji[0] != "U+2705" {
+		t.Errorf("Merge = %+v, want forbidden \"!\" and emoji U+2705", got)
+	}
+}
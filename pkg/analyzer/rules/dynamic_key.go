@@ -0,0 +1,18 @@
+package rules
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// CheckDynamicKey reports an attribute key that is not a compile-time constant.
+// Keys built at run time, e.g. fmt.Sprintf("count_%s", name), create a new
+// field in the log index for every distinct value.
+func CheckDynamicKey(pass *analysis.Pass, expr ast.Expr) {
+	pass.Report(analysis.Diagnostic{
+		Pos:     expr.Pos(),
+		End:     expr.End(),
+		Message: "attribute key must be a constant, group the values or log the variable part as a value attribute",
+	})
+}
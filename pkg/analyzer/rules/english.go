@@ -1,43 +1,93 @@
 package rules
 
 import (
+	"fmt"
 	"go/ast"
+	"strconv"
 	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/tools/go/analysis"
 )
 
-// nonLatinScripts is a list of unicode range tables for non-Latin scripts.
-var nonLatinScripts = []*unicode.RangeTable{
-	unicode.Cyrillic,
-	unicode.Han,
-	unicode.Arabic,
-	unicode.Hebrew,
-	unicode.Devanagari,
-	unicode.Hiragana,
-	unicode.Katakana,
-	unicode.Hangul,
-	unicode.Thai,
-	unicode.Georgian,
-	unicode.Armenian,
-	unicode.Greek,
-	unicode.Ethiopic,
-	unicode.Myanmar,
-	unicode.Khmer,
+// CheckEnglish reports every run of letters, digits and punctuation of a
+// script outside scripts, naming the script. Words separated only by spaces
+// and punctuation form one run. Words with letters of the Latin script
+// outside ASCII or combining diacritical marks, such as "conexión", are
+// reported unless scripts or det allow them. A message without such
+// findings is scored by det and reported if it reads as another language,
+// unless scripts allow the Latin script as a whole.
+func CheckEnglish(pass *analysis.Pass, subject Subject, msg string, lit *ast.BasicLit, scripts *ScriptPolicy, det *LanguageDetector) {
+	found := false
+	for _, run := range scriptRuns(msg, scripts) {
+		pass.Report(analysis.Diagnostic{
+			Pos:      litPos(lit, run.start),
+			End:      litPos(lit, run.end),
+			Category: subject.Category(),
+			Message:  fmt.Sprintf("%s must be in English only, found %s script %q", subject, run.script, msg[run.start:run.end]),
+		})
+		found = true
+	}
+	for _, w := range latinExtendedWords(msg) {
+		word := msg[w.start:w.end]
+		if scripts.AllowsLatin() || det.Allowed(word) || allowedWord(word, scripts) {
+			continue
+		}
+		pass.Report(analysis.Diagnostic{
+			Pos:      litPos(lit, w.start),
+			End:      litPos(lit, w.end),
+			Category: subject.Category(),
+			Message:  string(subject) + " must be in English only, found " + strconv.Quote(word),
+		})
+		found = true
+	}
+	if found || scripts.AllowsLatin() {
+		return
+	}
+	if name, confidence, ok := det.Detect(msg); ok {
+		pass.Report(analysis.Diagnostic{
+			Pos:      litPos(lit, 0),
+			End:      litPos(lit, len(msg)),
+			Category: subject.Category(),
+			Message:  fmt.Sprintf("%s must be in English only, it reads as %s (confidence %.2f)", subject, name, confidence),
+		})
+	}
+}
+
+// isLatinExtended reports whether r is a letter of the Latin script outside
+// ASCII, such as 'ñ', 'ß' or 'ơ', or a combining diacritical mark.
+func isLatinExtended(r rune) bool {
+	return r >= utf8.RuneSelf && unicode.Is(unicode.Latin, r) ||
+		r >= 0x0300 && r <= 0x036F
+}
+
+// allowedWord reports whether scripts allow every rune of word.
+func allowedWord(word string, scripts *ScriptPolicy) bool {
+	for _, r := range word {
+		if !scripts.Allowed(r) {
+			return false
+		}
+	}
+	return true
 }
 
-// CheckEnglish reports if a log message contains non-Latin script characters.
-func CheckEnglish(pass *analysis.Pass, msg string, lit *ast.BasicLit) {
-	for _, r := range msg {
-		for _, script := range nonLatinScripts {
-			if unicode.Is(script, r) {
-				pass.Report(analysis.Diagnostic{
-					Pos:     lit.Pos(),
-					End:     lit.End(),
-					Message: "log message must be in English only",
-				})
-				return
+// latinExtendedWords returns the words of msg, runs of letters and marks,
+// with at least one rune matching isLatinExtended.
+func latinExtendedWords(msg string) []span {
+	var words []span
+	start, extended := -1, false
+	for i, r := range msg + " " {
+		if unicode.IsLetter(r) || unicode.Is(unicode.Mn, r) {
+			if start < 0 {
+				start, extended = i, false
 			}
+			extended = extended || isLatinExtended(r)
+			continue
+		}
+		if start >= 0 && extended {
+			words = append(words, span{start, i})
 		}
+		start = -1
 	}
+	return words
 }
@@ -1,11 +1,15 @@
 package rules
 
 import (
+	"slices"
 	"testing"
-	"unicode"
 )
 
-func TestIsNonLatin(t *testing.T) {
+func TestScriptRunsDefault(t *testing.T) {
+	policy, err := CompileScripts(DefaultScripts)
+	if err != nil {
+		t.Fatal(err)
+	}
 	tests := []struct {
 		msg     string
 		wantBad bool
@@ -18,27 +22,49 @@ func TestIsNonLatin(t *testing.T) {
 		{"خطأ في الاتصال", true}, // Arabic
 		{"שגיאת חיבור", true},    // Hebrew
 		{"エラーが発生しました", true},     // Katakana/Hiragana
+		{"সংযোগ ব্যর্থ", true},   // Bengali
+		{"இணைப்பு தோல்வி", true}, // Tamil
+		{"ᏣᎳᎩ", true},            // Cherokee
 		{"user created: john@example.com", false},
 		{"request timeout after 30s", false},
+		{"conexión fallida", false}, // left to latinExtendedWords
+		{"started \U0001F680", false},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.msg, func(t *testing.T) {
-			got := hasNonLatinScript(tc.msg)
+			got := len(scriptRuns(tc.msg, policy)) > 0
 			if got != tc.wantBad {
-				t.Errorf("hasNonLatinScript(%q) = %v, want %v", tc.msg, got, tc.wantBad)
+				t.Errorf("scriptRuns(%q) found %v, want %v", tc.msg, got, tc.wantBad)
 			}
 		})
 	}
 }
 
-func hasNonLatinScript(msg string) bool {
-	for _, r := range msg {
-		for _, script := range nonLatinScripts {
-			if unicode.Is(script, r) {
-				return true
-			}
+func TestScriptRuns(t *testing.T) {
+	policy, err := CompileScripts(DefaultScripts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		msg  string
+		want []string
+	}{
+		{"starting server", nil},
+		{"Запуск сервера", []string{"Cyrillic:Запуск сервера"}},
+		{"user Иван logged in as Админ", []string{"Cyrillic:Иван", "Cyrillic:Админ"}},
+		{"ошибка: 服务器!", []string{"Cyrillic:ошибка", "Han:服务器"}},
+		{"服务器启动失败。", []string{"Han:服务器启动失败。"}},
+		{"id=42 имя", []string{"Cyrillic:имя"}},
+		{"count ２", []string{"Common:２"}},
+	}
+	for _, tc := range tests {
+		var got []string
+		for _, s := range scriptRuns(tc.msg, policy) {
+			got = append(got, s.script+":"+tc.msg[s.start:s.end])
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("scriptRuns(%q) = %q, want %q", tc.msg, got, tc.want)
 		}
 	}
-	return false
 }
@@ -0,0 +1,62 @@
+package rules
+
+import "strings"
+
+// printfVerbs is the set of verb letters understood by the fmt package.
+const printfVerbs = "vTtbcdoOqxXUeEfFgGspw"
+
+// formatVerb is a printf verb found in a format string.
+type formatVerb struct {
+	// Start and End are the byte offsets of the verb including flags.
+	Start, End int
+	// Verb is the verb letter, e.g. 'd'.
+	Verb byte
+	// Flags holds the flag characters, e.g. "+" in "%+v".
+	Flags string
+	// Args is the number of arguments the verb consumes, including '*' widths.
+	Args int
+	// Indexed reports whether the verb uses an explicit argument index like %[1]d.
+	Indexed bool
+}
+
+// parseVerbs returns the printf verbs in format in order.
+// "%%" is not a verb, and a '%' not followed by a valid verb is ignored.
+func parseVerbs(format string) []formatVerb {
+	var verbs []formatVerb
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		v := formatVerb{Start: i, Args: 1}
+		j := i + 1
+		for j < len(format) && strings.IndexByte("+-# 0", format[j]) >= 0 {
+			j++
+		}
+		v.Flags = format[i+1 : j]
+		for j < len(format) && strings.IndexByte("0123456789.*[]", format[j]) >= 0 {
+			switch format[j] {
+			case '*':
+				v.Args++
+			case '[':
+				v.Indexed = true
+			}
+			j++
+		}
+		if j >= len(format) {
+			break
+		}
+		if format[j] == '%' && j == i+1 {
+			// "%%" is a literal percent sign
+			i = j
+			continue
+		}
+		if strings.IndexByte(printfVerbs, format[j]) < 0 {
+			continue
+		}
+		v.Verb = format[j]
+		v.End = j + 1
+		verbs = append(verbs, v)
+		i = j
+	}
+	return verbs
+}
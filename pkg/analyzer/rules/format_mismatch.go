@@ -0,0 +1,135 @@
+package rules
+
+import (
+	"go/ast"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// FormatCall describes a log call checked by CheckFormatMismatch.
+type FormatCall struct {
+	// Method is the identifier of the called method, renamed by fixes.
+	Method *ast.Ident
+	// Msg is the message argument and Format its constant value.
+	Msg    ast.Expr
+	Format string
+	// Args are the arguments following the message.
+	Args []ast.Expr
+	// Printf reports whether the method formats its message like fmt.Sprintf.
+	Printf bool
+	// Sibling is the counterpart method with the opposite formatting behaviour,
+	// e.g. "Infof" for "Info", or "" if the logger has none.
+	Sibling string
+}
+
+// CheckFormatMismatch reports printf verbs passed to a method that does not
+// format its message, and verb/argument count mismatches in printf methods.
+// When a sibling method fits the call, a SuggestedFix renames the method.
+func CheckFormatMismatch(pass *analysis.Pass, fc FormatCall) {
+	verbs := parseVerbs(fc.Format)
+	if fc.Printf {
+		checkPrintfArgs(pass, fc, verbs)
+		return
+	}
+
+	// "100% done" parses as a verb with a space flag, it is almost always prose
+	var found []formatVerb
+	for _, v := range verbs {
+		if v.Flags != " " {
+			found = append(found, v)
+		}
+	}
+	if len(found) == 0 {
+		return
+	}
+
+	v := found[0]
+	msg := fc.Method.Name + " does not support format verbs such as " +
+		strconv.Quote(fc.Format[v.Start:v.End])
+	if fc.Sibling == "" {
+		pass.Report(analysis.Diagnostic{
+			Pos:     fc.Msg.Pos(),
+			End:     fc.Msg.End(),
+			Message: msg + ", pass values as attributes",
+		})
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     fc.Msg.Pos(),
+		End:     fc.Msg.End(),
+		Message: msg + ", use " + fc.Sibling,
+	}
+	if n, ok := verbArgs(found); ok && n == len(fc.Args) {
+		diag.SuggestedFixes = renameMethod(fc)
+	}
+	pass.Report(diag)
+}
+
+// checkPrintfArgs compares the arguments consumed by verbs with the call arguments.
+func checkPrintfArgs(pass *analysis.Pass, fc FormatCall, verbs []formatVerb) {
+	n, ok := verbArgs(verbs)
+	if !ok || n == len(fc.Args) {
+		return
+	}
+
+	if n == 0 {
+		diag := analysis.Diagnostic{
+			Pos:     fc.Msg.Pos(),
+			End:     fc.Msg.End(),
+			Message: fc.Method.Name + " call has arguments but no format verbs",
+		}
+		if fc.Sibling != "" {
+			diag.Message += ", use " + fc.Sibling
+			diag.SuggestedFixes = renameMethod(fc)
+		}
+		pass.Report(diag)
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos: fc.Msg.Pos(),
+		End: fc.Msg.End(),
+		Message: fc.Method.Name + " format " + strconv.Quote(fc.Format) + " reads " +
+			plural(n, "argument") + ", but call has " + strconv.Itoa(len(fc.Args)),
+	})
+}
+
+// verbArgs returns the number of arguments consumed by verbs.
+// It fails if any verb uses an explicit argument index.
+func verbArgs(verbs []formatVerb) (int, bool) {
+	n := 0
+	for _, v := range verbs {
+		if v.Indexed {
+			return 0, false
+		}
+		n += v.Args
+	}
+	return n, true
+}
+
+// renameMethod returns a fix replacing the called method with its sibling.
+func renameMethod(fc FormatCall) []analysis.SuggestedFix {
+	return []analysis.SuggestedFix{
+		{
+			Message: "use " + fc.Sibling,
+			TextEdits: []analysis.TextEdit{
+				{
+					Pos:     fc.Method.Pos(),
+					End:     fc.Method.End(),
+					NewText: []byte(fc.Sibling),
+				},
+			},
+		},
+	}
+}
+
+// plural formats n with a noun, e.g. "1 argument" or "2 arguments".
+func plural(n int, noun string) string {
+	s := strconv.Itoa(n) + " " + noun
+	if n != 1 {
+		s += "s"
+	}
+	return s
+}
@@ -0,0 +1,34 @@
+package rules
+
+import "testing"
+
+func TestParseVerbs(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+		args   int
+	}{
+		{"processed %d items in %s", "ds", 2},
+		{"progress %5.2f%%", "f", 1},
+		{"%+v and %#x", "vx", 2},
+		{"width %*d", "d", 2},
+		{"100% done", "d", 1},
+		{"50%", "", 0},
+		{"%z is not a verb", "", 0},
+		{"no verbs", "", 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			var got []byte
+			args := 0
+			for _, v := range parseVerbs(tc.format) {
+				got = append(got, v.Verb)
+				args += v.Args
+			}
+			if string(got) != tc.want || args != tc.args {
+				t.Errorf("parseVerbs(%q) = %q (%d args), want %q (%d args)",
+					tc.format, got, args, tc.want, tc.args)
+			}
+		})
+	}
+}
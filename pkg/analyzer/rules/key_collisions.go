@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// DefaultReservedKeys lists the keys written by the default handlers of each
+// logger family. Using them as attributes produces duplicate fields.
+var DefaultReservedKeys = map[string][]string{
+	"slog": {"time", "level", "msg", "source"},
+	"zap":  {"ts", "level", "msg", "logger", "caller", "stacktrace"},
+	"logr": {"level", "msg", "logger", "error"},
+}
+
+// KeyUse is a constant attribute key attached to a logger or log call.
+type KeyUse struct {
+	// Pos and End locate the key, or the attribute for implicit keys.
+	Pos, End token.Pos
+	// Name is the key qualified with its enclosing groups, e.g. "http.method".
+	Name string
+	// Nested reports whether the key is inside a group or namespace.
+	Nested bool
+	// Group reports whether the key names a group or namespace.
+	Group bool
+	// Value is the attribute value, nil for groups and namespaces.
+	Value ast.Expr
+}
+
+// CheckKeyCollisions reports keys of a call that are reserved by the handler
+// or that repeat a key attached earlier, either by a With call in the logger
+// chain (prior) or by the call itself. Duplicates point at the first occurrence.
+// Reserved keys are only reported at the top level, groups give them a new name.
+func CheckKeyCollisions(pass *analysis.Pass, prior, own []KeyUse, reserved []string) {
+	first := make(map[string]KeyUse, len(prior)+len(own))
+	for _, k := range prior {
+		if _, ok := first[k.Name]; !ok {
+			first[k.Name] = k
+		}
+	}
+
+	for _, k := range own {
+		if !k.Nested && contains(reserved, k.Name) {
+			pass.Report(analysis.Diagnostic{
+				Pos:     k.Pos,
+				End:     k.End,
+				Message: "attribute key " + strconv.Quote(k.Name) + " is reserved by the log handler",
+			})
+		}
+		if prev, ok := first[k.Name]; ok {
+			pass.Report(analysis.Diagnostic{
+				Pos:     k.Pos,
+				End:     k.End,
+				Message: "duplicate attribute key " + strconv.Quote(k.Name),
+				Related: []analysis.RelatedInformation{
+					{
+						Pos:     prev.Pos,
+						End:     prev.End,
+						Message: "first use of " + strconv.Quote(k.Name),
+					},
+				},
+			})
+			continue
+		}
+		first[k.Name] = k
+	}
+}
+
+// contains reports whether list contains s.
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Registry describes the package that declares every attribute key as a constant.
+type Registry struct {
+	// Path is the import path of the registry package.
+	Path string
+	// Name is the package name of the registry.
+	Name string
+	// Consts maps key values to the names of the constants declaring them.
+	Consts map[string]string
+	// Loaded reports whether the registry is visible from the analyzed package.
+	// If not, its entries are unknown and literal keys are reported without fixes.
+	Loaded bool
+}
+
+// CheckKeyRegistry reports a string literal attribute key. If the registry declares
+// a constant with the same value, a SuggestedFix replaces the literal with it,
+// qualified by qualifier or, if the file does not import the registry yet, by the
+// registry name together with a new import.
+func CheckKeyRegistry(pass *analysis.Pass, file *ast.File, lit *ast.BasicLit, key string, reg *Registry, qualifier string) {
+	if !reg.Loaded {
+		pass.Report(analysis.Diagnostic{
+			Pos:     lit.Pos(),
+			End:     lit.End(),
+			Message: "attribute key " + strconv.Quote(key) + " must be a constant from " + strconv.Quote(reg.Path),
+		})
+		return
+	}
+
+	name, ok := reg.Consts[key]
+	if !ok {
+		pass.Report(analysis.Diagnostic{
+			Pos:     lit.Pos(),
+			End:     lit.End(),
+			Message: "attribute key " + strconv.Quote(key) + " has no entry in key registry " + strconv.Quote(reg.Path),
+		})
+		return
+	}
+
+	edits := []analysis.TextEdit{}
+	if qualifier == "" {
+		qualifier = reg.Name
+		edits = append(edits, addImport(file, reg.Path)...)
+	}
+	ref := qualifier + "." + name
+	edits = append(edits, analysis.TextEdit{
+		Pos:     lit.Pos(),
+		End:     lit.End(),
+		NewText: []byte(ref),
+	})
+
+	pass.Report(analysis.Diagnostic{
+		Pos:     lit.Pos(),
+		End:     lit.End(),
+		Message: "attribute key " + strconv.Quote(key) + " must use " + ref,
+		SuggestedFixes: []analysis.SuggestedFix{
+			{
+				Message:   "use " + ref,
+				TextEdits: edits,
+			},
+		},
+	})
+}
+
+// addImport returns the edits that import path into file, as gofmt would
+// lay them out. The path is added to the first import declaration, which is
+// parenthesized if it was not. An import "C" declaration is left alone for
+// its cgo preamble; without any other import a new declaration follows the
+// existing imports or the package clause.
+func addImport(file *ast.File, path string) []analysis.TextEdit {
+	pos := file.Name.End()
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		if gen.Lparen.IsValid() {
+			return []analysis.TextEdit{{
+				Pos:     gen.Rparen,
+				End:     gen.Rparen,
+				NewText: []byte("\t" + strconv.Quote(path) + "\n"),
+			}}
+		}
+		if spec := gen.Specs[0].(*ast.ImportSpec); spec.Path.Value != `"C"` {
+			return []analysis.TextEdit{
+				{Pos: spec.Pos(), End: spec.Pos(), NewText: []byte("(\n\t")},
+				{Pos: spec.End(), End: spec.End(), NewText: []byte("\n\t" + strconv.Quote(path) + "\n)")},
+			}
+		}
+		pos = gen.End()
+	}
+	sep := "\n"
+	if pos == file.Name.End() {
+		sep = "\n\n"
+	}
+	return []analysis.TextEdit{{
+		Pos:     pos,
+		End:     pos,
+		NewText: []byte(sep + "import " + strconv.Quote(path)),
+	}}
+}
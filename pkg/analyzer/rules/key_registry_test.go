@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestAddImport(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "parenthesized",
+			src:  "package p\n\nimport (\n\t\"fmt\"\n)\n",
+			want: "package p\n\nimport (\n\t\"fmt\"\n\t\"logkeys\"\n)\n",
+		},
+		{
+			name: "single",
+			src:  "package p\n\nimport \"fmt\"\n",
+			want: "package p\n\nimport (\n\t\"fmt\"\n\t\"logkeys\"\n)\n",
+		},
+		{
+			name: "named",
+			src:  "package p\n\nimport f \"fmt\"\n\nimport \"os\"\n",
+			want: "package p\n\nimport (\n\tf \"fmt\"\n\t\"logkeys\"\n)\n\nimport \"os\"\n",
+		},
+		{
+			name: "cgo",
+			src:  "package p\n\n// #include <stdio.h>\nimport \"C\"\n",
+			want: "package p\n\n// #include <stdio.h>\nimport \"C\"\nimport \"logkeys\"\n",
+		},
+		{
+			name: "cgo and single",
+			src:  "package p\n\nimport \"C\"\n\nimport \"fmt\"\n",
+			want: "package p\n\nimport \"C\"\n\nimport (\n\t\"fmt\"\n\t\"logkeys\"\n)\n",
+		},
+		{
+			name: "none",
+			src:  "package p\n",
+			want: "package p\n\nimport \"logkeys\"\n",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "p.go", tc.src, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := tc.src
+			edits := addImport(file, "logkeys")
+			for i := len(edits) - 1; i >= 0; i-- {
+				start := fset.Position(edits[i].Pos).Offset
+				end := fset.Position(edits[i].End).Offset
+				got = got[:start] + string(edits[i].NewText) + got[end:]
+			}
+			if got != tc.want {
+				t.Errorf("addImport:\n%s\nwant:\n%s", got, tc.want)
+			}
+		})
+	}
+}
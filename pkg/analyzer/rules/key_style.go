@@ -0,0 +1,151 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// KeyStyle is a naming convention for attribute keys.
+type KeyStyle string
+
+const (
+	// SnakeCase keys look like "user_id".
+	SnakeCase KeyStyle = "snake_case"
+	// CamelCase keys look like "userId". Acronyms are capitalized as words,
+	// so "userID" does not match.
+	CamelCase KeyStyle = "camelCase"
+	// KebabCase keys look like "user-id".
+	KebabCase KeyStyle = "kebab-case"
+	// DotSeparated keys look like "http.status_code".
+	DotSeparated KeyStyle = "dot.separated"
+)
+
+// keyStylePatterns holds the pattern a key must match for each style.
+var keyStylePatterns = map[KeyStyle]*regexp.Regexp{
+	SnakeCase:    regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`),
+	CamelCase:    regexp.MustCompile(`^[a-z][a-z0-9]*([A-Z][a-z0-9]+)*[A-Z]?$`),
+	KebabCase:    regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+	DotSeparated: regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*(\.[a-z0-9]+(_[a-z0-9]+)*)*$`),
+}
+
+// Valid reports whether s is a known key style.
+func (s KeyStyle) Valid() bool {
+	_, ok := keyStylePatterns[s]
+	return ok
+}
+
+// CheckKeyStyle reports an attribute key that does not follow style.
+// Keys listed in exceptions are accepted as is. If the key is a string literal,
+// a SuggestedFix renames it to the converted key.
+func CheckKeyStyle(pass *analysis.Pass, expr ast.Expr, key string, style KeyStyle, exceptions []string) {
+	pattern, ok := keyStylePatterns[style]
+	if !ok || key == "" || pattern.MatchString(key) {
+		return
+	}
+	for _, e := range exceptions {
+		if e == key {
+			return
+		}
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     expr.Pos(),
+		End:     expr.End(),
+		Message: "attribute key " + strconv.Quote(key) + " must be " + string(style),
+	}
+	converted := ConvertKey(key, style)
+	if lit, ok := ast.Unparen(expr).(*ast.BasicLit); ok && lit.Kind == token.STRING &&
+		converted != "" && pattern.MatchString(converted) {
+		diag.Message += " (" + strconv.Quote(converted) + ")"
+		diag.SuggestedFixes = []analysis.SuggestedFix{
+			{
+				Message: "rename key to " + strconv.Quote(converted),
+				TextEdits: []analysis.TextEdit{
+					{
+						Pos:     lit.Pos(),
+						End:     lit.End(),
+						NewText: []byte(strconv.Quote(converted)),
+					},
+				},
+			},
+		}
+	}
+	pass.Report(diag)
+}
+
+// ConvertKey rewrites key in the given style, e.g. "userID" becomes
+// "user_id" in snake_case. For dot.separated keys existing dots are kept
+// and each segment is converted to snake_case.
+func ConvertKey(key string, style KeyStyle) string {
+	switch style {
+	case SnakeCase:
+		return strings.Join(lowerWords(splitKeyWords(key)), "_")
+	case KebabCase:
+		return strings.Join(lowerWords(splitKeyWords(key)), "-")
+	case DotSeparated:
+		segments := strings.Split(key, ".")
+		for i, seg := range segments {
+			segments[i] = ConvertKey(seg, SnakeCase)
+		}
+		return strings.Join(segments, ".")
+	case CamelCase:
+		words := lowerWords(splitKeyWords(key))
+		for i := 1; i < len(words); i++ {
+			words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+		}
+		return strings.Join(words, "")
+	}
+	return key
+}
+
+// splitKeyWords splits a key into words at separators and case changes.
+// Acronyms stay together: "HTTPServerID" splits into "HTTP", "Server", "ID".
+func splitKeyWords(key string) []string {
+	var words []string
+	runes := []rune(key)
+	start := -1
+	flush := func(end int) {
+		if start >= 0 && end > start {
+			words = append(words, string(runes[start:end]))
+		}
+		start = -1
+	}
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush(i)
+			continue
+		}
+		if start < 0 {
+			start = i
+			continue
+		}
+		prev := runes[i-1]
+		switch {
+		case unicode.IsUpper(r) && unicode.IsLower(prev):
+			// userID -> user|ID
+			flush(i)
+			start = i
+		case unicode.IsUpper(r) && unicode.IsUpper(prev) &&
+			i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+			// HTTPServer -> HTTP|Server
+			flush(i)
+			start = i
+		}
+	}
+	flush(len(runes))
+	return words
+}
+
+// lowerWords lowercases every word in place.
+func lowerWords(words []string) []string {
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return words
+}
@@ -0,0 +1,58 @@
+package rules
+
+import "testing"
+
+func TestConvertKey(t *testing.T) {
+	tests := []struct {
+		key   string
+		style KeyStyle
+		want  string
+	}{
+		{"userID", SnakeCase, "user_id"},
+		{"UserId", SnakeCase, "user_id"},
+		{"user-id", SnakeCase, "user_id"},
+		{"HTTPServerID", SnakeCase, "http_server_id"},
+		{"user id", SnakeCase, "user_id"},
+		{"user_id", CamelCase, "userId"},
+		{"RequestID", CamelCase, "requestId"},
+		{"userID", CamelCase, "userId"},
+		{"user_id", KebabCase, "user-id"},
+		{"httpStatusCode", KebabCase, "http-status-code"},
+		{"http.statusCode", DotSeparated, "http.status_code"},
+		{"userID", DotSeparated, "user_id"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.key+"/"+string(tc.style), func(t *testing.T) {
+			if got := ConvertKey(tc.key, tc.style); got != tc.want {
+				t.Errorf("ConvertKey(%q, %s) = %q, want %q", tc.key, tc.style, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestKeyStylePatterns(t *testing.T) {
+	tests := []struct {
+		key   string
+		style KeyStyle
+		want  bool
+	}{
+		{"user_id", SnakeCase, true},
+		{"userID", SnakeCase, false},
+		{"user__id", SnakeCase, false},
+		{"userId", CamelCase, true},
+		{"userID", CamelCase, false},
+		{"pointX", CamelCase, true},
+		{"UserID", CamelCase, false},
+		{"user-id", KebabCase, true},
+		{"user_id", KebabCase, false},
+		{"http.status_code", DotSeparated, true},
+		{"http..code", DotSeparated, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.key+"/"+string(tc.style), func(t *testing.T) {
+			if got := keyStylePatterns[tc.style].MatchString(tc.key); got != tc.want {
+				t.Errorf("%s matches %q = %v, want %v", tc.style, tc.key, got, tc.want)
+			}
+		})
+	}
+}
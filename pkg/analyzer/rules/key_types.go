@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/idakhno/golangster/pkg/schema"
+)
+
+// ValueKind returns the kind a log index sees for a value of type t: one of
+// the schema kinds string, number, bool or duration, or "" if t has no
+// comparable kind (structs, slices, interfaces, ...).
+func ValueKind(t types.Type) string {
+	if t == nil {
+		return ""
+	}
+	if named, ok := types.Unalias(t).(*types.Named); ok {
+		obj := named.Obj()
+		if obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Duration" {
+			return schema.KindDuration
+		}
+	}
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return ""
+	}
+	switch info := basic.Info(); {
+	case info&types.IsString != 0:
+		return schema.KindString
+	case info&types.IsBoolean != 0:
+		return schema.KindBool
+	case info&(types.IsInteger|types.IsFloat) != 0:
+		return schema.KindNumber
+	}
+	return ""
+}
+
+// KeySite is a place where an attribute key is logged with a value of some kind.
+type KeySite struct {
+	// Kind is the value kind, see ValueKind.
+	Kind string
+	// Pos locates the site if it is known to the current file set.
+	Pos, End token.Pos
+	// Where describes the site for the diagnostic, e.g. a package path.
+	Where string
+}
+
+// ReportKeyTypeConflict reports that key, logged with a value of kind at expr,
+// is logged with a different kind at other.
+func ReportKeyTypeConflict(pass *analysis.Pass, expr ast.Node, key, kind string, other KeySite) {
+	diag := analysis.Diagnostic{
+		Pos: expr.Pos(),
+		End: expr.End(),
+		Message: "attribute key " + strconv.Quote(key) + " is logged as " + kind +
+			", but as " + other.Kind + " " + other.Where,
+	}
+	if other.Pos.IsValid() {
+		diag.Related = []analysis.RelatedInformation{
+			{
+				Pos:     other.Pos,
+				End:     other.End,
+				Message: strconv.Quote(key) + " logged as " + other.Kind,
+			},
+		}
+	}
+	pass.Report(diag)
+}
@@ -0,0 +1,38 @@
+package rules
+
+import (
+	"go/types"
+	"testing"
+
+	"github.com/idakhno/golangster/pkg/schema"
+)
+
+func TestValueKind(t *testing.T) {
+	timePkg := types.NewPackage("time", "time")
+	duration := types.NewNamed(types.NewTypeName(0, timePkg, "Duration", nil), types.Typ[types.Int64], nil)
+	otherPkg := types.NewPackage("example.com/ids", "ids")
+	userID := types.NewNamed(types.NewTypeName(0, otherPkg, "UserID", nil), types.Typ[types.String], nil)
+
+	tests := []struct {
+		name string
+		typ  types.Type
+		want string
+	}{
+		{"string", types.Typ[types.String], schema.KindString},
+		{"int", types.Typ[types.Int], schema.KindNumber},
+		{"float64", types.Typ[types.Float64], schema.KindNumber},
+		{"untyped int", types.Typ[types.UntypedInt], schema.KindNumber},
+		{"bool", types.Typ[types.Bool], schema.KindBool},
+		{"time.Duration", duration, schema.KindDuration},
+		{"named string", userID, schema.KindString},
+		{"slice", types.NewSlice(types.Typ[types.String]), ""},
+		{"nil", nil, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ValueKind(tc.typ); got != tc.want {
+				t.Errorf("ValueKind(%v) = %q, want %q", tc.typ, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package rules
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:embed languages.txt
+var embeddedLanguages string
+
+// DefaultLanguageThreshold is the confidence from which a message is reported
+// as written in another language.
+const DefaultLanguageThreshold = 0.6
+
+// language is the model of one language: stop words and the n-grams typical
+// of its words.
+type language struct {
+	code, name string
+	stop       map[string]bool
+	ngrams     []string
+}
+
+// languageModel is the parsed embedded model; the first entry is English.
+var languageModel = sync.OnceValue(func() []*language {
+	langs, err := parseLanguages(embeddedLanguages)
+	if err != nil {
+		panic(err)
+	}
+	return langs
+})
+
+// parseLanguages parses a language model file, see languages.txt.
+func parseLanguages(data string) ([]*language, error) {
+	var langs []*language
+	byCode := make(map[string]*language)
+	for n, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		head, values, ok := strings.Cut(line, ":")
+		code, field, ok2 := strings.Cut(head, " ")
+		if !ok || !ok2 {
+			return nil, fmt.Errorf("languages.txt:%d: want \"<code> <field>: <values>\"", n+1)
+		}
+		l := byCode[code]
+		if l == nil {
+			l = &language{code: code, name: code, stop: make(map[string]bool)}
+			byCode[code] = l
+			langs = append(langs, l)
+		}
+		switch field {
+		case "name":
+			l.name = strings.TrimSpace(values)
+		case "stop":
+			for _, w := range strings.Fields(values) {
+				l.stop[w] = true
+			}
+		case "ngrams":
+			l.ngrams = append(l.ngrams, strings.Fields(values)...)
+		default:
+			return nil, fmt.Errorf("languages.txt:%d: unknown field %q", n+1, field)
+		}
+	}
+	return langs, nil
+}
+
+// LanguageDetector finds messages written in a Latin-script language other
+// than English.
+type LanguageDetector struct {
+	dict      *Dictionary
+	threshold float64
+	allowed   map[string]bool
+}
+
+// NewLanguageDetector returns a detector reporting messages scored at least
+// threshold, between 0 and 1, for another language. dict tells English words
+// apart; if it is nil, or threshold is above 1, messages are not scored and
+// only letters outside ASCII are reported. The words of allowlist, such as
+// product names and proper nouns, are never reported, ignoring case.
+func NewLanguageDetector(dict *Dictionary, threshold float64, allowlist []string) *LanguageDetector {
+	d := &LanguageDetector{dict: dict, threshold: threshold, allowed: make(map[string]bool, len(allowlist))}
+	for _, w := range allowlist {
+		d.allowed[strings.ToLower(w)] = true
+	}
+	return d
+}
+
+// Allowed reports whether word is on the allowlist.
+func (d *LanguageDetector) Allowed(word string) bool {
+	return d != nil && d.allowed[strings.ToLower(word)]
+}
+
+// Detect scores msg against the embedded language model and returns the
+// most likely language other than English with its confidence, ok if the
+// confidence reaches the threshold. Messages of fewer than two words are
+// not scored.
+//
+// The confidence is the share of words that are neither English stop words
+// nor in the English dictionary. The language is the one with the most
+// votes: a point for each of its stop words, half a point for each word
+// containing one of its n-grams. A single n-gram is no evidence, "chaos"
+// ends like a Spanish word, so it takes more than half a point; below that,
+// unknown words are taken for names or jargon and nothing is reported.
+func (d *LanguageDetector) Detect(msg string) (name string, confidence float64, ok bool) {
+	if d == nil || d.dict == nil || d.threshold > 1 {
+		return "", 0, false
+	}
+	words := d.languageWords(msg)
+	if len(words) < 2 {
+		return "", 0, false
+	}
+	langs := languageModel()
+	english, others := langs[0], langs[1:]
+	votes := make([]float64, len(others))
+	foreign := 0
+	for _, w := range words {
+		if english.stop[w] || d.dict.Known(w) {
+			continue
+		}
+		foreign++
+		for i, l := range others {
+			switch {
+			case l.stop[w]:
+				votes[i]++
+			case l.matchNgram(w):
+				votes[i] += 0.5
+			}
+		}
+	}
+	best := 0
+	for i, v := range votes {
+		if v > votes[best] {
+			best = i
+		}
+	}
+	if votes[best] <= 0.5 {
+		return "", 0, false
+	}
+	confidence = float64(foreign) / float64(len(words))
+	return others[best].name, confidence, confidence >= d.threshold
+}
+
+// matchNgram reports whether word contains one of the n-grams of l.
+func (l *language) matchNgram(word string) bool {
+	for _, g := range l.ngrams {
+		inner := strings.TrimSuffix(strings.TrimPrefix(g, "^"), "$")
+		var ok bool
+		switch {
+		case strings.HasPrefix(g, "^") && strings.HasSuffix(g, "$"):
+			ok = word == inner
+		case strings.HasPrefix(g, "^"):
+			ok = strings.HasPrefix(word, inner) && len(word) > len(inner)
+		case strings.HasSuffix(g, "$"):
+			ok = strings.HasSuffix(word, inner) && len(word) > len(inner)
+		default:
+			ok = strings.Contains(word, inner)
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// languageWords returns the lowercase words of msg that are scored: single
+// letters, printf verbs, URLs, paths, tokens with digits, identifiers such as
+// "userID" or "max_conns", acronyms and allowlisted words are left out.
+func (d *LanguageDetector) languageWords(msg string) []string {
+	masked := []byte(msg)
+	for _, v := range parseVerbs(msg) {
+		for i := v.Start; i < v.End; i++ {
+			masked[i] = ' '
+		}
+	}
+	var words []string
+	for _, field := range strings.Fields(string(masked)) {
+		word := strings.TrimFunc(field, func(r rune) bool { return !unicode.IsLetter(r) })
+		if utf8.RuneCountInString(word) < 2 || skipToken(field) || isMixedCase(word) || isAcronym(word) || d.Allowed(word) ||
+			strings.IndexFunc(word, func(r rune) bool { return !unicode.IsLetter(r) && r != '\'' }) >= 0 {
+			continue
+		}
+		words = append(words, strings.ToLower(word))
+	}
+	return words
+}
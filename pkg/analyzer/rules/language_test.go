@@ -0,0 +1,97 @@
+package rules
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLanguageModel(t *testing.T) {
+	langs := languageModel()
+	if langs[0].code != "en" {
+		t.Fatalf("first language is %q, want en", langs[0].code)
+	}
+	for _, l := range langs[1:] {
+		if l.name == l.code || len(l.stop) == 0 {
+			t.Errorf("language %q has no name or stop words", l.code)
+		}
+	}
+	if _, err := parseLanguages("de stop und\nde color: rot"); err == nil {
+		t.Error("parseLanguages accepted an unknown field")
+	}
+}
+
+func TestDetect(t *testing.T) {
+	det := NewLanguageDetector(NewDictionary(nil), DefaultLanguageThreshold, []string{"Vercel"})
+	tests := []struct {
+		msg  string
+		want string
+	}{
+		{"Verbindung fehlgeschlagen", "German"},
+		{"Fehler beim Laden der Konfiguration", "German"},
+		{"conexion fallida con el servidor", "Spanish"},
+		{"no se pudo conectar a la base de datos", "Spanish"},
+		{"impossible de se connecter au serveur", "French"},
+		{"errore durante la connessione", "Italian"},
+		{"falha ao conectar com o servidor", "Portuguese"},
+		{"verbinding met de server mislukt", "Dutch"},
+		{"nie udało się połączyć z serwerem", "Polish"},
+		{"gagal terhubung ke server", "Indonesian"},
+		{"failed to connect to database", ""},
+		{"starting server on port %d", ""},
+		{"kubelet restarted after eviction", ""},
+		{"user %s deleted the schedule", ""},
+		{"retrying request in the background", ""},
+		{"cache miss for key userID", ""},
+		{"payment processed", ""},
+		{"Verbindung", ""},
+		{"conexion fallida", ""},
+		{"chaos monkey", ""},
+		{"chaos monkey enabled", ""},
+		{"kafka consumer rebalancing", ""},
+	}
+	for _, tc := range tests {
+		name, confidence, ok := det.Detect(tc.msg)
+		if !ok {
+			name = ""
+		}
+		if name != tc.want {
+			t.Errorf("Detect(%q) = %q (confidence %.2f), want %q", tc.msg, name, confidence, tc.want)
+		}
+	}
+}
+
+func TestDetectDisabled(t *testing.T) {
+	for _, det := range []*LanguageDetector{
+		nil,
+		NewLanguageDetector(nil, DefaultLanguageThreshold, nil),
+		NewLanguageDetector(NewDictionary(nil), 1.1, nil),
+	} {
+		if _, _, ok := det.Detect("Verbindung fehlgeschlagen"); ok {
+			t.Errorf("Detect reported a language with detector %+v", det)
+		}
+	}
+}
+
+func TestLatinExtendedWords(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want []string
+	}{
+		{"connection failed", nil},
+		{"conexión fallida", []string{"conexión"}},
+		{"Straße and café", []string{"Straße", "café"}},
+		{"café opened", []string{"café"}},
+		{"kết nối thất bại", []string{"kết", "nối", "thất", "bại"}},
+		{"запуск", nil},
+		{"temperature 40°", nil},
+	}
+	for _, tc := range tests {
+		var got []string
+		for _, s := range latinExtendedWords(tc.msg) {
+			got = append(got, tc.msg[s.start:s.end])
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("latinExtendedWords(%q) = %q, want %q", tc.msg, got, tc.want)
+		}
+	}
+}
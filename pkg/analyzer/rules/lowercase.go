@@ -3,46 +3,75 @@ package rules
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
+	"strings"
 	"unicode"
-	"unicode/utf8"
 
 	"golang.org/x/tools/go/analysis"
 )
 
 // CheckLowercase reports if a log message starts with an uppercase letter.
-// A SuggestedFix is included to convert the first letter to lowercase.
-func CheckLowercase(pass *analysis.Pass, msg string, lit *ast.BasicLit) {
-	if len(msg) == 0 {
-		return
+// A SuggestedFix is included to convert the first word to lowercase.
+// Acronyms, properNouns and Go identifiers may start the message, see
+// capitalAllowed.
+func CheckLowercase(pass *analysis.Pass, subject Subject, msg string, lit *ast.BasicLit, properNouns []string) {
+	CheckCaseStyle(pass, subject, msg, lit, 0, LowerCase, properNouns)
+}
+
+// CheckLowercaseFrom is like CheckLowercase but inspects msg starting at byte
+// offset off.
+func CheckLowercaseFrom(pass *analysis.Pass, subject Subject, msg string, lit *ast.BasicLit, off int, properNouns []string) {
+	CheckCaseStyle(pass, subject, msg, lit, off, LowerCase, properNouns)
+}
+
+// firstWord returns the leading run of letters, digits and underscores of msg.
+func firstWord(msg string) string {
+	end := strings.IndexFunc(msg, func(r rune) bool { return !isWordRune(r) })
+	if end < 0 {
+		return msg
 	}
-	r, _ := utf8.DecodeRuneInString(msg)
-	if r == utf8.RuneError || !unicode.IsUpper(r) {
-		return
+	return msg[:end]
+}
+
+// capitalAllowed reports whether word keeps its capital letters at the start
+// of a message: an acronym such as "HTTP" or "IDs", one of properNouns, or a
+// Go identifier, either mixed case like "NewServer" or exported by pkg.
+func capitalAllowed(word string, properNouns []string, pkg *types.Package) bool {
+	for _, noun := range properNouns {
+		if word == noun {
+			return true
+		}
 	}
+	return isAcronym(word) || isMixedCase(word) ||
+		pkg != nil && token.IsExported(word) && pkg.Scope().Lookup(word) != nil
+}
 
-	// Compute the position of the first character inside the string literal.
-	// lit.Pos() points to the opening quote, +1 is the first character.
-	firstCharPos := lit.Pos() + 1
-	// Width of the first character in bytes within the source file.
-	firstCharEnd := token.Pos(int(firstCharPos) + utf8.RuneLen(r))
-
-	lower := string(unicode.ToLower(r))
-
-	pass.Report(analysis.Diagnostic{
-		Pos:     lit.Pos(),
-		End:     lit.End(),
-		Message: "log message must start with a lowercase letter",
-		SuggestedFixes: []analysis.SuggestedFix{
-			{
-				Message: "convert first letter to lowercase",
-				TextEdits: []analysis.TextEdit{
-					{
-						Pos:     firstCharPos,
-						End:     firstCharEnd,
-						NewText: []byte(lower),
-					},
-				},
-			},
-		},
-	})
+// isAcronym reports whether word has at least two letters, all upper case,
+// optionally followed by a plural "s": "ID", "TLS", "URLs".
+func isAcronym(word string) bool {
+	word = strings.TrimSuffix(word, "s")
+	letters := 0
+	for _, r := range word {
+		if unicode.IsLetter(r) {
+			if !unicode.IsUpper(r) {
+				return false
+			}
+			letters++
+		}
+	}
+	return letters >= 2
+}
+
+// isMixedCase reports whether word has an upper case letter after a lower
+// case one, as in the Go identifiers "NewServer" or "ServeHTTP", or contains
+// an underscore or a digit after a letter.
+func isMixedCase(word string) bool {
+	var prev rune
+	for i, r := range word {
+		if i > 0 && (unicode.IsLower(prev) && unicode.IsUpper(r) || r == '_' || unicode.IsDigit(r)) {
+			return true
+		}
+		prev = r
+	}
+	return false
 }
@@ -1,6 +1,8 @@
 package rules
 
 import (
+	"go/token"
+	"go/types"
 	"testing"
 	"unicode"
 	"unicode/utf8"
@@ -13,16 +15,30 @@ func TestCheckLowercaseLogic(t *testing.T) {
 	}{
 		{"starting server", false},
 		{"Starting server", true},
-		{"ERROR: something", true},
+		{"ERROR: something", false},
 		{"error: something", false},
 		{"", false},
 		{"123 digits first", false},
 		{"Ошибка подключения", true},
+		{"HTTP server started", false},
+		{"ID not found", false},
+		{"IDs loaded", false},
+		{"JSON decode failed", false},
+		{"TLS handshake error", false},
+		{"A request failed", true},
+		{"Kafka consumer started", false},
+		{"NewServer failed", false},
+		{"ServeHTTP returned", false},
+		{"Handler registered", false},
+		{"Handlers registered", true},
 	}
 
+	pkg := types.NewPackage("example.com/app", "app")
+	pkg.Scope().Insert(types.NewTypeName(token.NoPos, pkg, "Handler", nil))
+
 	for _, tc := range tests {
 		t.Run(tc.msg, func(t *testing.T) {
-			got := isUpperStart(tc.msg)
+			got := isUpperStart(tc.msg, pkg)
 			if got != tc.wantBad {
 				t.Errorf("isUpperStart(%q) = %v, want %v", tc.msg, got, tc.wantBad)
 			}
@@ -30,11 +46,27 @@ func TestCheckLowercaseLogic(t *testing.T) {
 	}
 }
 
-// isUpperStart is a helper for tests that checks uppercase start without a pass.
-func isUpperStart(msg string) bool {
-	if len(msg) == 0 {
-		return false
+// isUpperStart is a helper for tests that applies the CheckLowercase logic without a pass.
+func isUpperStart(msg string, pkg *types.Package) bool {
+	word := firstWord(msg)
+	r, _ := utf8.DecodeRuneInString(word)
+	return unicode.IsUpper(r) && !capitalAllowed(word, []string{"Kafka", "Postgres"}, pkg)
+}
+
+func TestFirstWord(t *testing.T) {
+	tests := []struct {
+		msg, want string
+	}{
+		{"Starting server", "Starting"},
+		{"HTTP/2 enabled", "HTTP"},
+		{"user_id missing", "user_id"},
+		{"Échec", "Échec"},
+		{": nothing", ""},
+		{"", ""},
+	}
+	for _, tc := range tests {
+		if got := firstWord(tc.msg); got != tc.want {
+			t.Errorf("firstWord(%q) = %q, want %q", tc.msg, got, tc.want)
+		}
 	}
-	r, _ := utf8.DecodeRuneInString(msg)
-	return r != utf8.RuneError && unicode.IsUpper(r)
 }
@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"go/ast"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// LengthLimits are the limits of the message-length rule.
+// A zero limit is not checked.
+type LengthLimits struct {
+	MaxChars int
+	MaxWords int
+	MinWords int
+}
+
+// Merge returns l with the non-zero limits of o applied on top.
+func (l LengthLimits) Merge(o LengthLimits) LengthLimits {
+	if o.MaxChars != 0 {
+		l.MaxChars = o.MaxChars
+	}
+	if o.MaxWords != 0 {
+		l.MaxWords = o.MaxWords
+	}
+	if o.MinWords != 0 {
+		l.MinWords = o.MinWords
+	}
+	return l
+}
+
+// CheckMessageLength reports a resolved constant message that is longer or
+// shorter than limits allow. If format is set, msg is a printf format and each
+// verb counts as one character and one word, since its value is not known.
+func CheckMessageLength(pass *analysis.Pass, subject Subject, node ast.Node, msg string, format bool, limits LengthLimits) {
+	if format {
+		msg = renderVerbs(msg)
+	}
+	chars := utf8.RuneCountInString(msg)
+	words := countWords(msg)
+
+	switch {
+	case limits.MaxChars > 0 && chars > limits.MaxChars:
+		pass.Reportf(node.Pos(), "%s is %d characters long, limit is %d", subject, chars, limits.MaxChars)
+	case limits.MaxWords > 0 && words > limits.MaxWords:
+		pass.Reportf(node.Pos(), "%s has %s, limit is %d", subject, plural(words, "word"), limits.MaxWords)
+	case limits.MinWords > 0 && words < limits.MinWords:
+		pass.Reportf(node.Pos(), "%s has %s, at least %d required", subject, plural(words, "word"), limits.MinWords)
+	}
+}
+
+// renderVerbs replaces the verbs of a printf format with a one-character
+// placeholder and unescapes "%%".
+func renderVerbs(format string) string {
+	var b strings.Builder
+	last := 0
+	for _, v := range parseVerbs(format) {
+		b.WriteString(strings.ReplaceAll(format[last:v.Start], "%%", "%"))
+		b.WriteByte('0')
+		last = v.End
+	}
+	b.WriteString(strings.ReplaceAll(format[last:], "%%", "%"))
+	return b.String()
+}
+
+// countWords returns the number of whitespace-separated fields of text that
+// contain a letter or a digit.
+func countWords(text string) int {
+	n := 0
+	for _, field := range strings.Fields(text) {
+		if strings.IndexFunc(field, func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }) >= 0 {
+			n++
+		}
+	}
+	return n
+}
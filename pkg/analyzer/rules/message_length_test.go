@@ -0,0 +1,47 @@
+package rules
+
+import "testing"
+
+func TestRenderVerbs(t *testing.T) {
+	tests := []struct {
+		format, want string
+	}{
+		{"user %s logged in", "user 0 logged in"},
+		{"id=%d", "id=0"},
+		{"100%% done in %.2fs", "100% done in 0s"},
+		{"no verbs", "no verbs"},
+	}
+	for _, tc := range tests {
+		if got := renderVerbs(tc.format); got != tc.want {
+			t.Errorf("renderVerbs(%q) = %q, want %q", tc.format, got, tc.want)
+		}
+	}
+}
+
+func TestCountWords(t *testing.T) {
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"", 0},
+		{"err", 1},
+		{"connection failed", 2},
+		{"user 0 logged in", 4},
+		{"retry - attempt 2", 3},
+		{"  spaced   out  ", 2},
+	}
+	for _, tc := range tests {
+		if got := countWords(tc.text); got != tc.want {
+			t.Errorf("countWords(%q) = %d, want %d", tc.text, got, tc.want)
+		}
+	}
+}
+
+func TestLengthLimitsMerge(t *testing.T) {
+	base := LengthLimits{MaxChars: 120, MaxWords: 20, MinWords: 2}
+	got := base.Merge(LengthLimits{MaxChars: 400})
+	want := LengthLimits{MaxChars: 400, MaxWords: 20, MinWords: 2}
+	if got != want {
+		t.Errorf("Merge = %+v, want %+v", got, want)
+	}
+}
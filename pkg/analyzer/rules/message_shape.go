@@ -0,0 +1,105 @@
+package rules
+
+import (
+	"go/ast"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// ShapeContext describes where a string literal sits in the message.
+type ShapeContext struct {
+	// First and Last report whether the literal starts or ends the message.
+	First, Last bool
+	// TrailingNewline accepts a single trailing newline, which the standard
+	// log package only adds if it is missing.
+	TrailingNewline bool
+	// AddsNewline reports that the method appends a newline itself, as
+	// log.Println does, so a trailing newline produces an empty line.
+	AddsNewline bool
+}
+
+// CheckMessageShape reports trailing periods and colons, leading and trailing
+// whitespace, embedded newlines, carriage returns and tabs, and repeated
+// spaces. Each finding has a fix removing or collapsing the offending bytes.
+func CheckMessageShape(pass *analysis.Pass, subject Subject, msg string, lit *ast.BasicLit, ctx ShapeContext) {
+	report := func(start, end int, message, fixMessage, newText string) {
+		pos, endPos := litPos(lit, start), litPos(lit, end)
+		pass.Report(analysis.Diagnostic{
+			Pos:     pos,
+			End:     endPos,
+			Message: string(subject) + " " + message,
+			SuggestedFixes: []analysis.SuggestedFix{{
+				Message:   fixMessage,
+				TextEdits: []analysis.TextEdit{{Pos: pos, End: endPos, NewText: []byte(newText)}},
+			}},
+		})
+	}
+
+	start, end := 0, len(msg)
+	if ctx.Last {
+		if ctx.TrailingNewline && strings.HasSuffix(msg, "\n") {
+			end--
+		}
+		trimmed := len(strings.TrimRight(msg[:end], shapeSpace))
+		if trimmed < end {
+			if ctx.AddsNewline && strings.Contains(msg[trimmed:end], "\n") {
+				report(trimmed, end, "must not end with a newline, Println adds one", "remove trailing newline", "")
+			} else {
+				report(trimmed, end, "must not end with whitespace", "remove trailing whitespace", "")
+			}
+			end = trimmed
+		}
+	}
+	if ctx.First {
+		start = len(msg[:end]) - len(strings.TrimLeft(msg[:end], shapeSpace))
+		if start > 0 {
+			report(0, start, "must not start with whitespace", "remove leading whitespace", "")
+		}
+	}
+	if ctx.Last && end > start {
+		switch c := msg[end-1]; {
+		case c == '.' && !strings.HasSuffix(msg[start:end], ".."):
+			// an ellipsis is left to the special-chars rule
+			report(end-1, end, "must not end with a period", "remove trailing period", "")
+		case c == ':':
+			report(end-1, end, "must not end with a colon", "remove trailing colon", "")
+		}
+	}
+
+	// inner runs of whitespace collapse into a single space
+	for i := start; i < end; {
+		if !strings.ContainsRune(shapeSpace, rune(msg[i])) {
+			i++
+			continue
+		}
+		j := i
+		for j < end && strings.ContainsRune(shapeSpace, rune(msg[j])) {
+			j++
+		}
+		if name, ok := controlSpace(msg[i:j]); ok {
+			report(i, j, "must not contain "+name, "replace with a space", " ")
+		} else if j-i > 1 {
+			report(i, j, "must not contain repeated spaces", "collapse spaces", " ")
+		}
+		i = j
+	}
+}
+
+// shapeSpace lists the whitespace bytes checked by the message-shape rule.
+const shapeSpace = " \t\n\r"
+
+// controlSpace returns the name of the first newline, carriage return or tab in run.
+func controlSpace(run string) (string, bool) {
+	for _, c := range []byte(run) {
+		switch c {
+		case '\n':
+			return "a newline", true
+		case '\r':
+			return "a carriage return", true
+		case '\t':
+			return "a tab", true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,21 @@
+package rules
+
+import "testing"
+
+func TestControlSpace(t *testing.T) {
+	tests := []struct {
+		run  string
+		want string
+	}{
+		{"  ", ""},
+		{"\n", "a newline"},
+		{" \t ", "a tab"},
+		{"\r\n", "a carriage return"},
+	}
+	for _, tc := range tests {
+		got, ok := controlSpace(tc.run)
+		if got != tc.want || ok != (tc.want != "") {
+			t.Errorf("controlSpace(%q) = %q, %v, want %q", tc.run, got, ok, tc.want)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// litPos returns the source position of the byte at offset off of the
+// unquoted value of the string literal lit. Escape sequences are taken into
+// account: an offset inside the bytes an escape decodes to maps to the
+// backslash, an offset at len(value) maps to the closing quote.
+func litPos(lit *ast.BasicLit, off int) token.Pos {
+	src := lit.Value
+	i, n := 1, 0
+	for i < len(src)-1 && n < off {
+		size, width := litChar(src, i)
+		if n+width > off {
+			break
+		}
+		i += size
+		n += width
+	}
+	return lit.Pos() + token.Pos(i)
+}
+
+// litChar returns the number of source bytes of the character or escape
+// sequence starting at src[i] and the number of value bytes it decodes to.
+// Carriage returns in raw strings are discarded by the compiler.
+func litChar(src string, i int) (size, width int) {
+	if src[0] == '`' {
+		if src[i] == '\r' {
+			return 1, 0
+		}
+		return 1, 1
+	}
+	if src[i] != '\\' || i+1 >= len(src) {
+		return 1, 1
+	}
+	switch c := src[i+1]; c {
+	case 'x':
+		return 4, 1
+	case 'u', 'U':
+		size = 6
+		if c == 'U' {
+			size = 10
+		}
+		if i+size > len(src) {
+			return 2, 1
+		}
+		r, err := strconv.ParseUint(src[i+2:i+size], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(r)) {
+			return size, utf8.RuneLen(utf8.RuneError)
+		}
+		return size, utf8.RuneLen(rune(r))
+	case '0', '1', '2', '3', '4', '5', '6', '7':
+		return 4, 1
+	}
+	return 2, 1
+}
+
+// runeEdits returns the edits converting the runes of msg[start:end] with
+// conv, one edit per changed rune. A rune written as an escape sequence is
+// replaced by an escape sequence as well, other source bytes are not touched.
+func runeEdits(lit *ast.BasicLit, msg string, start, end int, conv func(rune) rune) []analysis.TextEdit {
+	var edits []analysis.TextEdit
+	for i, r := range msg[start:end] {
+		c := conv(r)
+		if c == r {
+			continue
+		}
+		i += start
+		pos, endPos := litPos(lit, i), litPos(lit, i+utf8.RuneLen(r))
+		src := lit.Value[pos-lit.Pos() : endPos-lit.Pos()]
+		edits = append(edits, analysis.TextEdit{Pos: pos, End: endPos, NewText: []byte(escapeLike(lit, src, c))})
+	}
+	return edits
+}
+
+// escapeLike returns r written in lit the way src is: as an escape sequence
+// if src is one, as for escapeForLit otherwise.
+func escapeLike(lit *ast.BasicLit, src string, r rune) string {
+	if !strings.HasPrefix(src, `\`) || len(src) < 2 {
+		return escapeForLit(lit, string(r))
+	}
+	switch {
+	case src[1] == 'U' || r > 0xFFFF:
+		return fmt.Sprintf(`\U%08x`, r)
+	case src[1] == 'u' || r >= utf8.RuneSelf:
+		return fmt.Sprintf(`\u%04x`, r)
+	}
+	return fmt.Sprintf(`\x%02x`, r)
+}
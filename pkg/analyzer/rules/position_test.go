@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"testing"
+	"unicode"
+)
+
+func TestLitPos(t *testing.T) {
+	tests := []struct {
+		src  string
+		off  int
+		want int
+	}{
+		{`"hello"`, 0, 1},
+		{`"hello"`, 5, 6},
+		{`"a\nb"`, 1, 2},
+		{`"a\nb"`, 2, 4},
+		{`"\x41b"`, 1, 5},
+		{`"\101b"`, 1, 5},
+		{`"\u00e9b"`, 1, 1}, // inside the two bytes of é
+		{`"\u00e9b"`, 2, 7},
+		{`"\U0001F680!"`, 4, 11},
+		{`"é!"`, 2, 3},
+		{"`a\r\nb`", 2, 4},
+	}
+	for _, tc := range tests {
+		lit := &ast.BasicLit{ValuePos: 1, Kind: token.STRING, Value: tc.src}
+		if got := int(litPos(lit, tc.off)) - 1; got != tc.want {
+			t.Errorf("litPos(%s, %d) = %d, want %d", tc.src, tc.off, got, tc.want)
+		}
+	}
+}
+
+func TestRuneEdits(t *testing.T) {
+	tests := []struct {
+		src, want string
+	}{
+		{`"Abc"`, `"abc"`},
+		{`"\x41bc"`, `"\x61bc"`},
+		{`"\101bc"`, `"\x61bc"`},
+		{`"Échec"`, `"échec"`},
+		{"`ÉCHEC`", "`échec`"},
+		{`"ÀBÇ"`, `"àbç"`},
+	}
+	for _, tc := range tests {
+		lit := &ast.BasicLit{ValuePos: 1, Kind: token.STRING, Value: tc.src}
+		msg, err := strconv.Unquote(tc.src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := []byte(tc.src)
+		edits := runeEdits(lit, msg, 0, len(msg), unicode.ToLower)
+		for i := len(edits) - 1; i >= 0; i-- {
+			e := edits[i]
+			got = append(got[:e.Pos-1], append(e.NewText, got[e.End-1:]...)...)
+		}
+		if string(got) != tc.want {
+			t.Errorf("runeEdits(%s) gives %s, want %s", tc.src, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package rules
+
+import (
+	"go/ast"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/idakhno/golangster/pkg/schema"
+)
+
+// SchemaCall is a structured log call checked against an event schema.
+type SchemaCall struct {
+	// Msg is the message expression.
+	Msg ast.Expr
+	// Message is the value of Msg, Const reports whether it is a constant.
+	Message string
+	Const   bool
+	// Level is the level of the call, "" if unknown.
+	Level string
+	// Prior are the keys attached by With calls in the logger chain.
+	Prior []KeyUse
+	// Own are the keys passed to the call itself.
+	Own []KeyUse
+}
+
+// CheckSchema reports a log call that does not match an event of the schema:
+// an unknown message, a wrong level, missing required attributes, unexpected
+// attributes and values of the wrong kind. Problems with keys attached by a
+// With call are reported at the message, pointing to the key.
+func CheckSchema(pass *analysis.Pass, s *schema.Schema, call SchemaCall) {
+	if !call.Const {
+		pass.Reportf(call.Msg.Pos(), "log message must be a constant declared in the event schema")
+		return
+	}
+	msg := call.Message
+
+	events := s.Lookup(msg)
+	if len(events) == 0 {
+		pass.Reportf(call.Msg.Pos(), "log message %s is not declared in the event schema", strconv.Quote(msg))
+		return
+	}
+	event := matchLevel(events, call.Level)
+	if event == nil {
+		pass.Reportf(call.Msg.Pos(), "event %s must be logged at %s level, not %s",
+			strconv.Quote(msg), events[0].Level, call.Level)
+		event = events[0]
+	}
+
+	present := make(map[string]bool)
+	check := func(k KeyUse, prior bool) {
+		if k.Group || present[k.Name] {
+			return
+		}
+		present[k.Name] = true
+
+		var message string
+		attr, _, ok := event.Attribute(k.Name)
+		if !ok {
+			message = "event " + strconv.Quote(msg) + " has unexpected attribute " + strconv.Quote(k.Name)
+		} else if kind := valueKind(pass, k.Value); kind != "" && attr.Kind != "" &&
+			attr.Kind != schema.KindAny && kind != attr.Kind {
+			message = "attribute " + strconv.Quote(k.Name) + " of event " + strconv.Quote(msg) +
+				" must be " + attr.Kind + ", not " + kind
+		} else {
+			return
+		}
+
+		if !prior {
+			pass.Report(analysis.Diagnostic{Pos: k.Pos, End: k.End, Message: message})
+			return
+		}
+		pass.Report(analysis.Diagnostic{
+			Pos:     call.Msg.Pos(),
+			End:     call.Msg.End(),
+			Message: message,
+			Related: []analysis.RelatedInformation{{
+				Pos:     k.Pos,
+				End:     k.End,
+				Message: "attribute " + strconv.Quote(k.Name) + " attached here",
+			}},
+		})
+	}
+	for _, k := range call.Prior {
+		check(k, true)
+	}
+	for _, k := range call.Own {
+		check(k, false)
+	}
+
+	for _, attr := range event.Required {
+		if !present[attr.Key] {
+			pass.Reportf(call.Msg.Pos(), "event %s is missing required attribute %s",
+				strconv.Quote(msg), strconv.Quote(attr.Key))
+		}
+	}
+}
+
+// matchLevel returns the first event logged at level or at any level.
+// A call with an unknown level matches every event.
+func matchLevel(events []*schema.Event, level string) *schema.Event {
+	for _, e := range events {
+		if e.Level == "" || level == "" || e.Level == level {
+			return e
+		}
+	}
+	return nil
+}
+
+// valueKind returns the kind of a value expression, "" if it has none.
+func valueKind(pass *analysis.Pass, value ast.Expr) string {
+	if value == nil {
+		return ""
+	}
+	return ValueKind(pass.TypesInfo.TypeOf(value))
+}
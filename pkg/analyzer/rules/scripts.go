@@ -0,0 +1,139 @@
+package rules
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// DefaultScripts allows ASCII letters, digits and punctuation only.
+var DefaultScripts = []string{"ASCII"}
+
+// PackageScripts allows additional scripts in the packages matching a glob,
+// see MatchPackage.
+type PackageScripts struct {
+	Package string
+	Scripts []string
+}
+
+// ScriptPolicy is a compiled allowlist of scripts and categories.
+type ScriptPolicy struct {
+	tables []*unicode.RangeTable
+	// latin is set if the Latin script as a whole is allowed
+	latin bool
+}
+
+// asciiTable holds U+0000 to U+007F.
+var asciiTable = &unicode.RangeTable{R16: []unicode.Range16{{Lo: 0, Hi: 0x7F, Stride: 1}}, LatinOffset: 1}
+
+// CompileScripts returns the policy allowing the runes of classes: "ASCII",
+// Unicode script names such as "Cyrillic", category names such as "L" or
+// "Nd", property names and code point ranges such as "U+0400-U+04FF".
+func CompileScripts(classes []string) (*ScriptPolicy, error) {
+	p := &ScriptPolicy{}
+	for _, class := range classes {
+		class = strings.TrimSpace(class)
+		if class == "ASCII" {
+			p.tables = append(p.tables, asciiTable)
+			continue
+		}
+		table, err := parseCharClass(class)
+		if err != nil {
+			return nil, err
+		}
+		p.tables = append(p.tables, table)
+		switch class {
+		case "Latin", "L", "Ll", "Lu":
+			p.latin = true
+		}
+	}
+	return p, nil
+}
+
+// Allowed reports whether r is allowed by p.
+func (p *ScriptPolicy) Allowed(r rune) bool {
+	for _, t := range p.tables {
+		if unicode.Is(t, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsLatin reports whether p allows the Latin script as a whole, so
+// that words with diacritics and other Latin-script languages are accepted.
+func (p *ScriptPolicy) AllowsLatin() bool {
+	return p.latin
+}
+
+// checkedByScript reports whether the script policy applies to r: letters,
+// marks, digits and punctuation. Symbols, emoji modifiers and the ellipsis
+// are left to the special-chars rule, spaces and controls to message-shape
+// and unicode-safety, Latin letters with diacritics to latinExtendedWords.
+func checkedByScript(r rune) bool {
+	return unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.P) &&
+		!isLatinExtended(r) && !unicode.Is(emojiModifiers, r) && r != ellipsis
+}
+
+// scriptNames lists the names of unicode.Scripts in order, so that the
+// script of a rune is found deterministically.
+var scriptNames = sync.OnceValue(func() []string {
+	names := make([]string, 0, len(unicode.Scripts))
+	for name := range unicode.Scripts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+})
+
+// scriptOf returns the Unicode script of r, such as "Cyrillic" or "Common".
+func scriptOf(r rune) string {
+	for _, name := range scriptNames() {
+		if name != "Common" && name != "Inherited" && unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+	if unicode.Is(unicode.Inherited, r) {
+		return "Inherited"
+	}
+	return "Common"
+}
+
+// scriptRun is a run of runes of one script outside the script policy.
+type scriptRun struct {
+	span
+	script string
+}
+
+// scriptRuns returns the runs of msg from a rune outside p to the last rune
+// of the same script. A run ends at an allowed letter or digit or at a rune
+// of another script, spaces and punctuation in between are included. Runes
+// of the Common and Inherited scripts, such as the ideographic full stop,
+// join the run next to them.
+func scriptRuns(msg string, p *ScriptPolicy) []scriptRun {
+	var runs []scriptRun
+	open := false
+	for i, r := range msg {
+		switch {
+		case checkedByScript(r) && !p.Allowed(r):
+			script := scriptOf(r)
+			switch {
+			case !open || runs[len(runs)-1].script != script && !neutralScript(script) && !neutralScript(runs[len(runs)-1].script):
+				runs = append(runs, scriptRun{span{start: i}, script})
+				open = true
+			case neutralScript(runs[len(runs)-1].script):
+				runs[len(runs)-1].script = script
+			}
+			runs[len(runs)-1].end = i + len(string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			open = false
+		}
+	}
+	return runs
+}
+
+// neutralScript reports whether runes of script are shared by many scripts.
+func neutralScript(script string) bool {
+	return script == "Common" || script == "Inherited"
+}
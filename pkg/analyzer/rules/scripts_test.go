@@ -0,0 +1,59 @@
+package rules
+
+import "testing"
+
+func TestCompileScripts(t *testing.T) {
+	tests := []struct {
+		classes []string
+		wantErr bool
+	}{
+		{DefaultScripts, false},
+		{[]string{"ASCII", "Cyrillic", "Greek"}, false},
+		{[]string{"ASCII", "Nd", "U+00C0-U+00FF"}, false},
+		{[]string{"Klingon"}, true},
+	}
+	for _, tc := range tests {
+		_, err := CompileScripts(tc.classes)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("CompileScripts(%q) error = %v, want error %v", tc.classes, err, tc.wantErr)
+		}
+	}
+}
+
+func TestScriptPolicy(t *testing.T) {
+	policy, err := CompileScripts([]string{"ASCII", "Cyrillic"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runs := scriptRuns("ошибка подключения", policy); len(runs) != 0 {
+		t.Errorf("Cyrillic reported with Cyrillic allowed: %v", runs)
+	}
+	if runs := scriptRuns("σφάλμα", policy); len(runs) != 1 || runs[0].script != "Greek" {
+		t.Errorf("scriptRuns(Greek) = %v, want one Greek run", runs)
+	}
+	if policy.AllowsLatin() {
+		t.Error("AllowsLatin() = true without Latin")
+	}
+	if latin, _ := CompileScripts([]string{"ASCII", "Latin"}); !latin.AllowsLatin() {
+		t.Error("AllowsLatin() = false with Latin")
+	}
+}
+
+func TestScriptOf(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want string
+	}{
+		{'a', "Latin"},
+		{'ж', "Cyrillic"},
+		{'ক', "Bengali"},
+		{'Ꮳ', "Cherokee"},
+		{'。', "Common"},
+		{'\u0301', "Inherited"},
+	}
+	for _, tc := range tests {
+		if got := scriptOf(tc.r); got != tc.want {
+			t.Errorf("scriptOf(%q) = %q, want %q", tc.r, got, tc.want)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package rules
 
 import (
 	"go/ast"
+	"go/token"
+	"strconv"
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
@@ -28,33 +30,33 @@ var DefaultSensitiveKeywords = []string{
 	"bearer",
 }
 
-// CheckSensitive reports if a log call may expose sensitive data.
+// CheckSensitive reports if a message expression may expose sensitive data.
 // It checks both string literals and variable names in concatenation expressions.
-func CheckSensitive(pass *analysis.Pass, call *ast.CallExpr, keywords []string) {
-	if len(call.Args) == 0 {
-		return
-	}
-	checkExprForSensitive(pass, call.Args[0], keywords)
+func CheckSensitive(pass *analysis.Pass, subject Subject, expr ast.Expr, keywords []string) {
+	checkExprForSensitive(pass, subject, expr, keywords)
 }
 
 // checkExprForSensitive recursively walks an expression looking for sensitive data.
-func checkExprForSensitive(pass *analysis.Pass, expr ast.Expr, keywords []string) {
+func checkExprForSensitive(pass *analysis.Pass, subject Subject, expr ast.Expr, keywords []string) {
 	switch e := expr.(type) {
 	case *ast.BasicLit:
 		// check the string literal for sensitive keywords
-		val := strings.Trim(e.Value, `"`+"`")
-		if kw, found := containsSensitiveKeyword(val, keywords); found {
+		val, err := strconv.Unquote(e.Value)
+		if e.Kind != token.STRING || err != nil {
+			return
+		}
+		for _, m := range findSensitiveKeywords(val, keywords) {
 			pass.Report(analysis.Diagnostic{
-				Pos:     e.Pos(),
-				End:     e.End(),
-				Message: "log message may expose sensitive data (keyword: \"" + kw + "\")",
+				Pos:     litPos(e, m.start),
+				End:     litPos(e, m.end),
+				Message: string(subject) + " may expose sensitive data (keyword: \"" + val[m.start:m.end] + "\")",
 			})
 		}
 
 	case *ast.BinaryExpr:
 		// concatenation - check both sides
-		checkExprForSensitive(pass, e.X, keywords)
-		checkExprForSensitive(pass, e.Y, keywords)
+		checkExprForSensitive(pass, subject, e.X, keywords)
+		checkExprForSensitive(pass, subject, e.Y, keywords)
 
 	case *ast.Ident:
 		// identifier - check the variable name itself
@@ -62,13 +64,47 @@ func checkExprForSensitive(pass *analysis.Pass, expr ast.Expr, keywords []string
 			pass.Report(analysis.Diagnostic{
 				Pos:     e.Pos(),
 				End:     e.End(),
-				Message: "log message may expose sensitive data via variable \"" + e.Name + "\" (keyword: \"" + kw + "\")",
+				Message: string(subject) + " may expose sensitive data via variable \"" + e.Name + "\" (keyword: \"" + kw + "\")",
 			})
 		}
 
 	case *ast.ParenExpr:
-		checkExprForSensitive(pass, e.X, keywords)
+		checkExprForSensitive(pass, subject, e.X, keywords)
+	}
+}
+
+// findSensitiveKeywords returns the non-overlapping occurrences of keywords
+// in s, ignoring ASCII case. The longest keyword wins at each position, so
+// "authorization" is found rather than "auth".
+func findSensitiveKeywords(s string, keywords []string) []span {
+	lower := asciiLower(s)
+	var found []span
+	for i := 0; i < len(lower); {
+		n := 0
+		for _, kw := range keywords {
+			if len(kw) > n && strings.HasPrefix(lower[i:], asciiLower(kw)) {
+				n = len(kw)
+			}
+		}
+		if n == 0 {
+			i++
+			continue
+		}
+		found = append(found, span{i, i + n})
+		i += n
+	}
+	return found
+}
+
+// asciiLower lowercases the ASCII letters of s, keeping byte offsets intact.
+func asciiLower(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if 'A' <= c && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
 	}
+	return string(b)
 }
 
 // containsSensitiveKeyword reports whether s contains any of the keywords (case-insensitive).
@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -60,3 +61,25 @@ func TestVariableNameSensitive(t *testing.T) {
 		})
 	}
 }
+
+func TestFindSensitiveKeywords(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"user login", nil},
+		{"password and token", []string{"password", "token"}},
+		{"Authorization header", []string{"Authorization"}},
+		{"TOKEN refreshed, old token revoked", []string{"TOKEN", "token"}},
+		{"api_key=x apikey=y", []string{"api_key", "apikey"}},
+	}
+	for _, tc := range tests {
+		var got []string
+		for _, s := range findSensitiveKeywords(tc.input, DefaultSensitiveKeywords) {
+			got = append(got, tc.input[s.start:s.end])
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("findSensitiveKeywords(%q) = %q, want %q", tc.input, got, tc.want)
+		}
+	}
+}
@@ -2,8 +2,10 @@ package rules
 
 import (
 	"go/ast"
+	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/tools/go/analysis"
 )
@@ -21,51 +23,168 @@ var emojiRanges = &unicode.RangeTable{
 	},
 }
 
-// forbiddenChars is the set of special characters not allowed in log messages.
-var forbiddenChars = map[rune]bool{
-	'!': true,
-	'?': true,
+// emojiModifiers continue an emoji sequence: the zero-width joiner of
+// sequences such as U+1F468 U+200D U+1F4BB, variation selectors and skin
+// tone modifiers.
+var emojiModifiers = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x200D, 0x200D, 1},
+		{0xFE00, 0xFE0F, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1F3FB, 0x1F3FF, 1},
+	},
+}
+
+// ellipsis is the single-character ellipsis U+2026.
+const ellipsis = '\u2026'
+
+// CheckSpecialChars reports every emoji sequence, special character, run of
+// repeated punctuation and ellipsis in a log message, each with a fix that
+// removes it or, between two clauses, replaces it with a comma. set decides
+// which characters are emoji or forbidden, see CharPolicy. Names, such as
+// attribute keys, must not contain whitespace either.
+func CheckSpecialChars(pass *analysis.Pass, subject Subject, msg string, lit *ast.BasicLit, set *CharSet) {
+	checkEmoji(pass, subject, msg, lit, set)
+	checkForbiddenChars(pass, subject, msg, lit, set)
+	checkRepeatedDots(pass, subject, msg, lit)
+	if subject.Category() != "" {
+		checkNameSpaces(pass, subject, msg, lit)
+	}
+}
+
+// checkNameSpaces reports the whitespace of a name without a fix: the
+// separator to use instead depends on the key convention, see CheckKeyStyle.
+func checkNameSpaces(pass *analysis.Pass, subject Subject, name string, lit *ast.BasicLit) {
+	for _, s := range runSpans(name, unicode.IsSpace) {
+		pass.Report(analysis.Diagnostic{
+			Pos:      litPos(lit, s.start),
+			End:      litPos(lit, s.end),
+			Category: subject.Category(),
+			Message:  string(subject) + " must not contain whitespace",
+		})
+	}
+}
+
+func checkEmoji(pass *analysis.Pass, subject Subject, msg string, lit *ast.BasicLit, set *CharSet) {
+	for _, s := range emojiSpans(msg, set) {
+		reportRemoval(pass, subject, lit, msg, s, false, string(subject)+" must not contain emoji", "remove emoji")
+	}
 }
 
-// CheckSpecialChars reports if a log message contains emoji or special characters.
-func CheckSpecialChars(pass *analysis.Pass, msg string, lit *ast.BasicLit) {
-	checkEmoji(pass, msg, lit)
-	checkForbiddenChars(pass, msg, lit)
-	checkRepeatedDots(pass, msg, lit)
+// emojiSpans returns the emoji sequences of msg: adjacent emoji, together
+// with the zero-width joiners, variation selectors and skin tone modifiers
+// between and after them.
+func emojiSpans(msg string, set *CharSet) []span {
+	var spans []span
+	for i := 0; i < len(msg); {
+		r, size := utf8.DecodeRuneInString(msg[i:])
+		if !set.Emoji(r) {
+			i += size
+			continue
+		}
+		start := i
+		for i < len(msg) {
+			r, size := utf8.DecodeRuneInString(msg[i:])
+			if !set.Emoji(r) && !unicode.Is(emojiModifiers, r) {
+				break
+			}
+			i += size
+		}
+		spans = append(spans, span{start, i})
+	}
+	return spans
 }
 
-func checkEmoji(pass *analysis.Pass, msg string, lit *ast.BasicLit) {
-	for _, r := range msg {
-		if unicode.Is(emojiRanges, r) {
-			pass.Report(analysis.Diagnostic{
-				Pos:     lit.Pos(),
-				End:     lit.End(),
-				Message: "log message must not contain emoji",
-			})
-			return
+func checkForbiddenChars(pass *analysis.Pass, subject Subject, msg string, lit *ast.BasicLit, set *CharSet) {
+	for _, s := range runSpans(msg, set.Forbidden) {
+		found := msg[s.start:s.end]
+		message := string(subject) + " must not contain special character '" + found + "'"
+		if utf8.RuneCountInString(found) > 1 {
+			message = string(subject) + " must not contain repeated punctuation " + strconv.Quote(found)
 		}
+		// only sentence punctuation ends a clause
+		clause := strings.Trim(found, "!?") == ""
+		reportRemoval(pass, subject, lit, msg, s, clause, message, "remove "+strconv.Quote(found))
 	}
 }
 
-func checkForbiddenChars(pass *analysis.Pass, msg string, lit *ast.BasicLit) {
-	for _, r := range msg {
-		if forbiddenChars[r] {
-			pass.Report(analysis.Diagnostic{
-				Pos:     lit.Pos(),
-				End:     lit.End(),
-				Message: "log message must not contain special character '" + string(r) + "'",
-			})
-			return
+// runSpans returns the runs of consecutive runes matching match.
+func runSpans(msg string, match func(rune) bool) []span {
+	var spans []span
+	open := false
+	for i, r := range msg {
+		if !match(r) {
+			open = false
+			continue
 		}
+		if !open {
+			spans = append(spans, span{start: i})
+			open = true
+		}
+		spans[len(spans)-1].end = i + utf8.RuneLen(r)
 	}
+	return spans
 }
 
-func checkRepeatedDots(pass *analysis.Pass, msg string, lit *ast.BasicLit) {
-	if strings.Contains(msg, "...") {
-		pass.Report(analysis.Diagnostic{
-			Pos:     lit.Pos(),
-			End:     lit.End(),
-			Message: "log message must not contain '...' (ellipsis)",
-		})
+func checkRepeatedDots(pass *analysis.Pass, subject Subject, msg string, lit *ast.BasicLit) {
+	for _, s := range runSpans(msg, func(r rune) bool { return r == '.' || r == ellipsis }) {
+		found := msg[s.start:s.end]
+		var message string
+		switch {
+		case strings.ContainsRune(found, ellipsis):
+			message = string(subject) + " must not contain '…' (ellipsis)"
+		case len(found) >= 3:
+			message = string(subject) + " must not contain '...' (ellipsis)"
+		default:
+			continue
+		}
+		reportRemoval(pass, subject, lit, msg, s, true, message, "remove ellipsis")
+	}
+}
+
+// reportRemoval reports msg[s.start:s.end] with a fix removing it, see removal.
+func reportRemoval(pass *analysis.Pass, subject Subject, lit *ast.BasicLit, msg string, s span, clause bool, message, fixMessage string) {
+	edit, text := removal(msg, s, clause)
+	if text != "" {
+		fixMessage = "replace " + strconv.Quote(msg[s.start:s.end]) + " with " + strconv.Quote(text)
+	}
+	pass.Report(analysis.Diagnostic{
+		Pos:      litPos(lit, s.start),
+		End:      litPos(lit, s.end),
+		Category: subject.Category(),
+		Message:  message,
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message: fixMessage,
+			TextEdits: []analysis.TextEdit{{
+				Pos:     litPos(lit, edit.start),
+				End:     litPos(lit, edit.end),
+				NewText: []byte(escapeForLit(lit, text)),
+			}},
+		}},
+	})
+}
+
+// removal returns the bytes of msg to replace to remove s and their
+// replacement, so that no double or dangling space is left: a space next
+// to s is removed along with it, and s between two words becomes a space.
+// If clause is set, punctuation ending a clause followed by more text
+// becomes a comma: "failed! retrying" gives "failed, retrying".
+func removal(msg string, s span, clause bool) (span, string) {
+	before, _ := utf8.DecodeLastRuneInString(msg[:s.start])
+	after, _ := utf8.DecodeRuneInString(msg[s.end:])
+	wordBefore := s.start > 0 && isWordRune(before)
+	wordAfter := s.end < len(msg) && isWordRune(after)
+
+	switch {
+	case wordBefore && wordAfter:
+		return s, " "
+	case clause && wordBefore && after == ' ' && strings.TrimSpace(msg[s.end:]) != "":
+		return s, ","
+	case s.start > 0 && before == ' ' && (s.end == len(msg) || after == ' '):
+		s.start--
+	case s.start == 0 && after == ' ':
+		s.end++
 	}
+	return s, ""
 }
@@ -1,6 +1,8 @@
 package rules
 
 import (
+	"slices"
+	"strings"
 	"testing"
 	"unicode"
 )
@@ -72,7 +74,7 @@ func TestRepeatedDots(t *testing.T) {
 
 func hasForbiddenChar(msg string) bool {
 	for _, r := range msg {
-		if forbiddenChars[r] {
+		if DefaultCharSet.Forbidden(r) {
 			return true
 		}
 	}
@@ -87,3 +89,73 @@ func hasEllipsis(msg string) bool {
 	}
 	return false
 }
+
+func TestEmojiSpans(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want []string
+	}{
+		{"server started", nil},
+		{"launch 🚀 ok 🎉", []string{"🚀", "🎉"}},
+		{"deploy 🚀🔥 done", []string{"🚀🔥"}},
+		{"dev 👨\u200d💻 here", []string{"👨\u200d💻"}},
+		{"warn ⚠\ufe0f", []string{"⚠\ufe0f"}},
+	}
+	for _, tc := range tests {
+		var got []string
+		for _, s := range emojiSpans(tc.msg, DefaultCharSet) {
+			got = append(got, tc.msg[s.start:s.end])
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("emojiSpans(%q) = %q, want %q", tc.msg, got, tc.want)
+		}
+	}
+}
+
+func TestRunSpans(t *testing.T) {
+	isBang := func(r rune) bool { return r == '!' || r == '?' }
+	tests := []struct {
+		msg  string
+		want []string
+	}{
+		{"done", nil},
+		{"done!", []string{"!"}},
+		{"failed!!! why?", []string{"!!!", "?"}},
+		{"what?!", []string{"?!"}},
+	}
+	for _, tc := range tests {
+		var got []string
+		for _, s := range runSpans(tc.msg, isBang) {
+			got = append(got, tc.msg[s.start:s.end])
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("runSpans(%q) = %q, want %q", tc.msg, got, tc.want)
+		}
+	}
+}
+
+func TestRemoval(t *testing.T) {
+	tests := []struct {
+		msg, found string
+		clause     bool
+		want       string
+	}{
+		{"server started!", "!", true, "server started"},
+		{"launch 🚀 ok", "🚀", false, "launch ok"},
+		{"🚀 launched", "🚀", false, "launched"},
+		{"started 🚀", "🚀", false, "started"},
+		{"failed! retrying", "!", true, "failed, retrying"},
+		{"failed! retrying", "!", false, "failed retrying"},
+		{"loading...done", "...", true, "loading done"},
+		{"loading… please wait", "…", true, "loading, please wait"},
+		{"really?!", "?!", true, "really"},
+		{"id=? missing", "?", true, "id= missing"},
+	}
+	for _, tc := range tests {
+		start := strings.Index(tc.msg, tc.found)
+		s, text := removal(tc.msg, span{start, start + len(tc.found)}, tc.clause)
+		if got := tc.msg[:s.start] + text + tc.msg[s.end:]; got != tc.want {
+			t.Errorf("removal(%q, %q) gives %q, want %q", tc.msg, tc.found, got, tc.want)
+		}
+	}
+}
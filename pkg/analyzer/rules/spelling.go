@@ -0,0 +1,345 @@
+package rules
+
+import (
+	_ "embed"
+	"go/ast"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+//go:embed words.txt
+var embeddedWords string
+
+// Dictionary is a set of correctly spelled lowercase words.
+type Dictionary struct {
+	words map[string]bool
+	// sorted lists the words for deterministic suggestions
+	sorted []string
+}
+
+// embeddedDictionary is the parsed embedded word list, shared by all dictionaries.
+var embeddedDictionary = sync.OnceValue(func() []string {
+	return ParseWordList(embeddedWords)
+})
+
+// NewDictionary returns the embedded English dictionary extended with extra words.
+func NewDictionary(extra []string) *Dictionary {
+	base := embeddedDictionary()
+	d := &Dictionary{words: make(map[string]bool, len(base)+len(extra))}
+	for _, list := range [][]string{base, extra} {
+		for _, w := range list {
+			w = strings.ToLower(w)
+			if !d.words[w] {
+				d.words[w] = true
+				d.sorted = append(d.sorted, w)
+			}
+		}
+	}
+	sort.Strings(d.sorted)
+	return d
+}
+
+// ParseWordList returns the words of a dictionary file: whitespace-separated
+// words, with lines starting with '#' ignored.
+func ParseWordList(data string) []string {
+	var words []string
+	for _, line := range strings.Split(data, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		words = append(words, strings.Fields(line)...)
+	}
+	return words
+}
+
+// inflections are the suffixes accepted after a dictionary word, with the
+// ending they replace: "retries" is "retry" + "ies" for "y".
+var inflections = []struct{ suffix, stem string }{
+	{"ies", "y"}, {"ied", "y"}, {"ily", "y"}, {"ier", "y"},
+	{"s", ""}, {"es", ""}, {"ed", ""}, {"ed", "e"}, {"ing", ""}, {"ing", "e"},
+	{"ly", ""}, {"er", ""}, {"er", "e"}, {"ers", ""}, {"ers", "e"}, {"est", ""},
+	{"able", ""}, {"able", "e"}, {"ment", ""}, {"ness", ""},
+}
+
+// suggestSuffixes are the inflections kept when suggesting a word by its stem.
+var suggestSuffixes = []string{"s", "es", "ed", "ing"}
+
+// prefixes are accepted before a dictionary word: "unsubscribed", "reconnect".
+var prefixes = []string{"un", "re", "pre", "non", "de", "dis", "sub", "over", "under", "multi", "auto", "co", "in", "mis"}
+
+// Known reports whether word is in the dictionary, possibly inflected or prefixed.
+func (d *Dictionary) Known(word string) bool {
+	word = strings.ToLower(word)
+	if d.known(word) {
+		return true
+	}
+	for _, p := range prefixes {
+		if rest, ok := strings.CutPrefix(word, p); ok && len(rest) >= 4 && d.known(rest) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Dictionary) known(word string) bool {
+	if d.words[word] {
+		return true
+	}
+	for _, in := range inflections {
+		base, ok := strings.CutSuffix(word, in.suffix)
+		if !ok || len(base) < 2 {
+			continue
+		}
+		base += in.stem
+		if d.words[base] {
+			return true
+		}
+		// doubled final consonant: "stopped", "running"
+		if in.stem == "" && len(base) > 2 && base[len(base)-1] == base[len(base)-2] && d.words[base[:len(base)-1]] {
+			return true
+		}
+	}
+	return false
+}
+
+// Suggest returns the dictionary word closest to word by edit distance.
+// Words shorter than 7 letters only match swapped adjacent letters, since a
+// single edit too often turns one short word into another. Longer words allow
+// one edit, and words of 9 letters or more two. Inflected words are matched
+// by their stem and keep their suffix: "conections" gives "connections".
+// A word that only adds or drops letters at either end of the closest word
+// is not reported.
+func (d *Dictionary) Suggest(word string) (string, bool) {
+	word = strings.ToLower(word)
+	maxDist := 1
+	if len(word) >= 9 {
+		maxDist = 2
+	}
+
+	best, bestDist := d.nearest(word, maxDist)
+	for _, suffix := range suggestSuffixes {
+		stem, ok := strings.CutSuffix(word, suffix)
+		if !ok || len(stem) < 4 {
+			continue
+		}
+		stemDist := 1
+		if len(stem) >= 9 {
+			stemDist = 2
+		}
+		c, _ := d.nearest(stem, stemDist)
+		if c == "" || len(stem) < 7 && !isTransposition(stem, c) ||
+			strings.HasSuffix(c, "s") || !d.Known(c+suffix) {
+			continue
+		}
+		if dist := editDistance(word, c+suffix, maxDist+1); dist < bestDist {
+			best, bestDist = c+suffix, dist
+		}
+	}
+	switch {
+	case best == "",
+		len(word) < 7 && !isTransposition(word, best),
+		// "violate" for "violated" is a base form missing from the dictionary
+		strings.HasPrefix(best, word), strings.HasPrefix(word, best),
+		strings.HasSuffix(best, word), strings.HasSuffix(word, best):
+		return "", false
+	}
+	return best, true
+}
+
+// nearest returns the closest dictionary word within maxDist edits of word.
+// Ties prefer words with the same first letter, then alphabetical order.
+func (d *Dictionary) nearest(word string, maxDist int) (string, int) {
+	best, bestDist := "", maxDist+1
+	for _, w := range d.sorted {
+		if abs(len(w)-len(word)) > maxDist {
+			continue
+		}
+		dist := editDistance(word, w, bestDist+1)
+		if dist < bestDist || dist == bestDist && best != "" && best[0] != word[0] && w[0] == word[0] {
+			best, bestDist = w, dist
+		}
+	}
+	return best, bestDist
+}
+
+// isTransposition reports whether b is a with two adjacent bytes swapped.
+func isTransposition(a, b string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	i := 0
+	for i < len(a) && a[i] == b[i] {
+		i++
+	}
+	return i+1 < len(a) && a[i] == b[i+1] && a[i+1] == b[i] && a[i+2:] == b[i+2:]
+}
+
+// editDistance returns the optimal string alignment distance between a and b,
+// counting insertions, deletions, substitutions and transpositions of adjacent
+// bytes. Distances of limit or more are returned as limit.
+func editDistance(a, b string, limit int) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, cur[j])
+		}
+		if rowMin >= limit {
+			return limit
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return min(prev[len(b)], limit)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// minSpellLen is the length below which words are not spell checked,
+// short words are too often abbreviations.
+const minSpellLen = 5
+
+// CheckSpelling reports words of msg that are not in dict but within a few
+// edits of a dictionary word, with a fix replacing them by that word.
+// Printf verbs, URLs, paths, e-mail addresses and tokens with digits (IDs,
+// hex values) are skipped, identifiers are split into their words, and
+// words in capitals are treated as acronyms.
+func CheckSpelling(pass *analysis.Pass, subject Subject, msg string, lit *ast.BasicLit, dict *Dictionary) {
+	for _, w := range spellWords(msg) {
+		word := msg[w.start:w.end]
+		if dict.Known(word) {
+			continue
+		}
+		suggestion, ok := dict.Suggest(word)
+		if !ok {
+			// unknown words without a close match are names or jargon
+			continue
+		}
+		suggestion = matchCase(suggestion, word)
+		pass.Report(analysis.Diagnostic{
+			Pos:     litPos(lit, w.start),
+			End:     litPos(lit, w.end),
+			Message: string(subject) + " has misspelled word " + strconv.Quote(word) + ", use " + strconv.Quote(suggestion),
+			SuggestedFixes: []analysis.SuggestedFix{{
+				Message: "replace " + strconv.Quote(word) + " with " + strconv.Quote(suggestion),
+				TextEdits: []analysis.TextEdit{{
+					Pos:     litPos(lit, w.start),
+					End:     litPos(lit, w.end),
+					NewText: []byte(escapeForLit(lit, suggestion)),
+				}},
+			}},
+		})
+	}
+}
+
+// span is a byte range of a message.
+type span struct{ start, end int }
+
+// spellWords returns the words of msg to spell check.
+func spellWords(msg string) []span {
+	// blank out printf verbs, keeping offsets
+	masked := []byte(msg)
+	for _, v := range parseVerbs(msg) {
+		for i := v.Start; i < v.End; i++ {
+			masked[i] = ' '
+		}
+	}
+	text := string(masked)
+
+	var words []span
+	for _, field := range fieldSpans(text) {
+		token := text[field.start:field.end]
+		if skipToken(token) {
+			continue
+		}
+		for _, part := range identifierWords(text, field) {
+			word := text[part.start:part.end]
+			if utf8.RuneCountInString(word) < minSpellLen || strings.ToUpper(word) == word {
+				continue
+			}
+			words = append(words, part)
+		}
+	}
+	return words
+}
+
+// fieldSpans returns the whitespace-separated fields of text.
+func fieldSpans(text string) []span {
+	var fields []span
+	start := -1
+	for i, r := range text {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				fields = append(fields, span{start, i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		fields = append(fields, span{start, len(text)})
+	}
+	return fields
+}
+
+// skipToken reports whether a field is a URL, path, e-mail address or an
+// identifier containing digits, which are not spell checked.
+func skipToken(token string) bool {
+	switch {
+	case strings.Contains(token, "://"), strings.HasPrefix(token, "www."),
+		strings.ContainsAny(token, "@/\\"),
+		strings.IndexFunc(token, unicode.IsDigit) >= 0:
+		return true
+	}
+	return false
+}
+
+// identifierWords splits a field into runs of letters, breaking camelCase
+// words at lower-to-upper changes: "userNmae_id" gives "user", "Nmae", "id".
+func identifierWords(text string, field span) []span {
+	var words []span
+	start := -1
+	var prev rune
+	for i, r := range text[field.start:field.end] {
+		i += field.start
+		letter := unicode.IsLetter(r)
+		boundary := letter && start >= 0 && unicode.IsLower(prev) && unicode.IsUpper(r)
+		if start >= 0 && (!letter || boundary) {
+			words = append(words, span{start, i})
+			start = -1
+		}
+		if letter && start < 0 {
+			start = i
+		}
+		prev = r
+	}
+	if start >= 0 {
+		words = append(words, span{start, field.end})
+	}
+	return words
+}
@@ -0,0 +1,71 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"connection", "connection", 0},
+		{"conection", "connection", 1},
+		{"recieved", "received", 1},
+		{"teh", "the", 1},
+		{"kitten", "sitting", 3},
+	}
+	for _, tc := range tests {
+		if got := editDistance(tc.a, tc.b, 10); got != tc.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+	if got := editDistance("kitten", "sitting", 2); got != 2 {
+		t.Errorf("editDistance with limit 2 = %d, want 2", got)
+	}
+}
+
+func TestDictionaryKnown(t *testing.T) {
+	d := NewDictionary([]string{"Golangster"})
+	for _, w := range []string{"connection", "Connections", "retries", "stopped", "reconnecting", "unsubscribed", "golangster"} {
+		if !d.Known(w) {
+			t.Errorf("Known(%q) = false, want true", w)
+		}
+	}
+	for _, w := range []string{"conection", "recieved", "golangstr"} {
+		if d.Known(w) {
+			t.Errorf("Known(%q) = true, want false", w)
+		}
+	}
+}
+
+func TestDictionarySuggest(t *testing.T) {
+	d := NewDictionary(nil)
+	tests := []struct {
+		word, want string
+	}{
+		{"conection", "connection"},
+		{"recieved", "received"},
+		{"conections", "connections"},
+		{"sucessful", "successful"},
+		{"xyzzyplugh", ""},
+	}
+	for _, tc := range tests {
+		if got, _ := d.Suggest(tc.word); got != tc.want {
+			t.Errorf("Suggest(%q) = %q, want %q", tc.word, got, tc.want)
+		}
+	}
+}
+
+func TestSpellWords(t *testing.T) {
+	msg := "user %s recieved https://example.com/x a1b2c3 userNmae_field HTTP /var/log ok"
+	var got []string
+	for _, w := range spellWords(msg) {
+		got = append(got, msg[w.start:w.end])
+	}
+	want := []string{"recieved", "field"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("spellWords = %q, want %q", got, want)
+	}
+}
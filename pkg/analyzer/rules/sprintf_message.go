@@ -0,0 +1,112 @@
+package rules
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// CheckSprintfMessage reports a structured log message built with fmt.Sprintf.
+// A SuggestedFix turns the format string into a constant message and each
+// verb's argument into an attribute produced by render, with keys inferred
+// in style. A nil render disables the fix.
+func CheckSprintfMessage(pass *analysis.Pass, expr ast.Expr, style KeyStyle, render AttrRenderer) {
+	if !IsSprintfCall(pass, expr) {
+		return
+	}
+	call := ast.Unparen(expr).(*ast.CallExpr)
+
+	diag := analysis.Diagnostic{
+		Pos:     call.Pos(),
+		End:     call.End(),
+		Message: "log message must not be built with fmt.Sprintf, use a constant message and typed attributes",
+	}
+	if render != nil {
+		if text, ok := sprintfFixText(pass, call, style, render); ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{
+				{
+					Message: "convert format arguments into attributes",
+					TextEdits: []analysis.TextEdit{
+						{
+							Pos:     expr.Pos(),
+							End:     expr.End(),
+							NewText: []byte(text),
+						},
+					},
+				},
+			}
+		}
+	}
+	pass.Report(diag)
+}
+
+// IsSprintfCall reports whether expr is a call to fmt.Sprintf with a format argument.
+func IsSprintfCall(pass *analysis.Pass, expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 || call.Ellipsis.IsValid() {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	fn, ok := pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == "fmt" && fn.Name() == "Sprintf"
+}
+
+// sprintfFixText builds the replacement for a fmt.Sprintf message:
+// the constant message followed by one attribute per format argument.
+func sprintfFixText(pass *analysis.Pass, call *ast.CallExpr, style KeyStyle, render AttrRenderer) (string, bool) {
+	tv, ok := pass.TypesInfo.Types[call.Args[0]]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+	text, verbs, ok := stripVerbs(constant.StringVal(tv.Value))
+	args := call.Args[1:]
+	if !ok || verbs != len(args) {
+		return "", false
+	}
+
+	msg := cleanMessage(text)
+	if msg == "" {
+		return "", false
+	}
+
+	var buf strings.Builder
+	buf.WriteString(strconv.Quote(msg))
+	seen := make(map[string]bool)
+	for _, arg := range args {
+		attr, ok := inferAttr(pass.Fset, arg, style)
+		if !ok || seen[attr.Key] {
+			return "", false
+		}
+		seen[attr.Key] = true
+		buf.WriteString(", ")
+		buf.WriteString(render(attr))
+	}
+	return buf.String(), true
+}
+
+// stripVerbs removes printf verbs from format and returns the remaining text
+// and the number of arguments the verbs consume. It fails for explicit
+// argument indexes and '*' widths, which cannot be mapped to attributes.
+func stripVerbs(format string) (string, int, bool) {
+	var text strings.Builder
+	last := 0
+	verbs := parseVerbs(format)
+	for _, v := range verbs {
+		if v.Indexed || v.Args != 1 {
+			return "", 0, false
+		}
+		text.WriteString(format[last:v.Start])
+		// keep words separated where the verb was
+		text.WriteByte(' ')
+		last = v.End
+	}
+	text.WriteString(format[last:])
+	return strings.ReplaceAll(text.String(), "%%", "%"), len(verbs), true
+}
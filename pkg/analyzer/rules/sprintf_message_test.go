@@ -0,0 +1,30 @@
+package rules
+
+import "testing"
+
+func TestStripVerbs(t *testing.T) {
+	tests := []struct {
+		format    string
+		wantText  string
+		wantVerbs int
+		wantOK    bool
+	}{
+		{"processed %d items in %s", "processed   items in  ", 2, true},
+		{"user %q created", "user   created", 1, true},
+		{"progress %5.2f%%", "progress  %", 1, true},
+		{"value %+v", "value  ", 1, true},
+		{"no verbs", "no verbs", 0, true},
+		{"%[1]d items", "", 0, false},
+		{"width %*d", "", 0, false},
+		{"trailing %", "trailing %", 0, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			text, verbs, ok := stripVerbs(tc.format)
+			if ok != tc.wantOK || text != tc.wantText || verbs != tc.wantVerbs {
+				t.Errorf("stripVerbs(%q) = %q, %d, %v, want %q, %d, %v",
+					tc.format, text, verbs, ok, tc.wantText, tc.wantVerbs, tc.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package rules
+
+import (
+	"bytes"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Attr is a structured attribute extracted from a dynamic log message.
+type Attr struct {
+	// Key is the attribute key inferred from the value expression.
+	Key string
+	// Value is the expression logged as the attribute value.
+	Value ast.Expr
+	// Src is the source text of Value.
+	Src string
+}
+
+// AttrRenderer renders an attribute as source code in the syntax of a logger,
+// e.g. `"id", id` for slog or `zap.Any("id", id)` for zap.
+type AttrRenderer func(attr Attr) string
+
+// CheckStaticMessage reports a structured log message that is not a compile-time constant.
+// When the message is a concatenation of literals and simple values, a SuggestedFix
+// rewrites it into a constant message followed by attributes produced by render,
+// with keys inferred in style. A nil render disables the fix.
+func CheckStaticMessage(pass *analysis.Pass, expr ast.Expr, style KeyStyle, render AttrRenderer) {
+	if isConstString(pass, expr) {
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     expr.Pos(),
+		End:     expr.End(),
+		Message: "log message must be a constant string, pass dynamic values as attributes",
+	}
+	if render != nil {
+		if msg, attrs, ok := splitMessage(pass, expr, style); ok {
+			var buf strings.Builder
+			buf.WriteString(strconv.Quote(msg))
+			for _, attr := range attrs {
+				buf.WriteString(", ")
+				buf.WriteString(render(attr))
+			}
+			diag.SuggestedFixes = []analysis.SuggestedFix{
+				{
+					Message: "move dynamic values into attributes",
+					TextEdits: []analysis.TextEdit{
+						{
+							Pos:     expr.Pos(),
+							End:     expr.End(),
+							NewText: []byte(buf.String()),
+						},
+					},
+				},
+			}
+		}
+	}
+	pass.Report(diag)
+}
+
+// isConstString reports whether expr is a constant string expression.
+func isConstString(pass *analysis.Pass, expr ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[expr]
+	return ok && tv.Value != nil && tv.Value.Kind() == constant.String
+}
+
+// splitMessage splits a concatenation into constant message text and attributes.
+// It fails if a dynamic part has no obvious key or the message would be empty.
+func splitMessage(pass *analysis.Pass, expr ast.Expr, style KeyStyle) (string, []Attr, bool) {
+	var text strings.Builder
+	var attrs []Attr
+	seen := make(map[string]bool)
+
+	for _, part := range flattenConcat(expr) {
+		if isConstString(pass, part) {
+			text.WriteString(constant.StringVal(pass.TypesInfo.Types[part].Value))
+			text.WriteByte(' ')
+			continue
+		}
+		attr, ok := inferAttr(pass.Fset, part, style)
+		if !ok || seen[attr.Key] {
+			return "", nil, false
+		}
+		seen[attr.Key] = true
+		attrs = append(attrs, attr)
+	}
+
+	msg := cleanMessage(text.String())
+	if msg == "" || len(attrs) == 0 {
+		return "", nil, false
+	}
+	return msg, attrs, true
+}
+
+// flattenConcat returns the operands of a "+" chain in source order.
+func flattenConcat(expr ast.Expr) []ast.Expr {
+	switch e := expr.(type) {
+	case *ast.BinaryExpr:
+		if e.Op == token.ADD {
+			return append(flattenConcat(e.X), flattenConcat(e.Y)...)
+		}
+	case *ast.ParenExpr:
+		return flattenConcat(e.X)
+	}
+	return []ast.Expr{expr}
+}
+
+// inferAttr derives an attribute key in style from a value expression, so
+// that the key-style rule accepts it: userID is logged as "user_id" in
+// snake_case. err.Error() and v.String() are logged as the receiver itself.
+func inferAttr(fset *token.FileSet, expr ast.Expr, style KeyStyle) (Attr, bool) {
+	value := expr
+	if call, ok := expr.(*ast.CallExpr); ok && len(call.Args) == 0 {
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok &&
+			(sel.Sel.Name == "Error" || sel.Sel.Name == "String") {
+			value = sel.X
+		}
+	}
+
+	var key string
+	switch v := value.(type) {
+	case *ast.Ident:
+		key = v.Name
+	case *ast.SelectorExpr:
+		key = v.Sel.Name
+	default:
+		return Attr{}, false
+	}
+	if key = ConvertKey(key, style); key == "" {
+		return Attr{}, false
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, value); err != nil {
+		return Attr{}, false
+	}
+	return Attr{Key: key, Value: value, Src: buf.String()}, true
+}
+
+// cleanMessage collapses whitespace and drops separators that introduced
+// the values moved into attributes, e.g. "user id: " becomes "user id".
+func cleanMessage(s string) string {
+	var words []string
+	for _, w := range strings.Fields(s) {
+		if w = strings.TrimRight(w, ":=,"); w != "" {
+			words = append(words, w)
+		}
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestCleanMessage(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"user   created ", "user created"},
+		{"failed:  ", "failed"},
+		{"user id= ", "user id"},
+		{"id=, name= ", "id name"},
+		{" : ", ""},
+		{"", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			if got := cleanMessage(tc.in); got != tc.want {
+				t.Errorf("cleanMessage(%q) = %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestInferAttrKeyStyle(t *testing.T) {
+	tests := []struct {
+		expr  string
+		style KeyStyle
+		want  string
+	}{
+		{"u.ID", SnakeCase, "id"},
+		{"userID", SnakeCase, "user_id"},
+		{"userID", KebabCase, "user-id"},
+		{"req.RequestID", CamelCase, "requestId"},
+		{"err.Error()", SnakeCase, "err"},
+		{"userID", "", "userID"},
+	}
+	for _, tc := range tests {
+		expr, err := parser.ParseExpr(tc.expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		attr, ok := inferAttr(token.NewFileSet(), expr, tc.style)
+		if !ok || attr.Key != tc.want {
+			t.Errorf("inferAttr(%s, %s) key = %q, %v, want %q", tc.expr, tc.style, attr.Key, ok, tc.want)
+		}
+	}
+}
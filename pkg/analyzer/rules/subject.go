@@ -0,0 +1,32 @@
+package rules
+
+import "strings"
+
+// Subject names the kind of string a rule is applied to.
+// It starts every diagnostic message, e.g. "log message must ...".
+type Subject string
+
+const (
+	// LogMessage is the message argument of a log call.
+	LogMessage Subject = "log message"
+	// ErrorString is the message passed to an error constructor.
+	ErrorString Subject = "error string"
+	// AttributeKey is the constant key of a log attribute.
+	AttributeKey Subject = "attribute key"
+	// GroupName names an attribute group: slog.Group, WithGroup or
+	// zap.Namespace.
+	GroupName Subject = "group name"
+	// LoggerName is the name given by zap Named or logr WithName.
+	LoggerName Subject = "logger name"
+)
+
+// Category returns the diagnostic category of findings in names, so that
+// they can be told apart from findings in messages: "attribute-key",
+// "group-name" or "logger-name". Messages and error strings have none.
+func (s Subject) Category() string {
+	switch s {
+	case AttributeKey, GroupName, LoggerName:
+		return strings.ReplaceAll(string(s), " ", "-")
+	}
+	return ""
+}
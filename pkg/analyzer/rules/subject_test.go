@@ -0,0 +1,21 @@
+package rules
+
+import "testing"
+
+func TestSubjectCategory(t *testing.T) {
+	tests := []struct {
+		subject Subject
+		want    string
+	}{
+		{LogMessage, ""},
+		{ErrorString, ""},
+		{AttributeKey, "attribute-key"},
+		{GroupName, "group-name"},
+		{LoggerName, "logger-name"},
+	}
+	for _, tc := range tests {
+		if got := tc.subject.Category(); got != tc.want {
+			t.Errorf("%q.Category() = %q, want %q", tc.subject, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Template is a convention the resolved messages of matching log calls must
+// follow. Empty Package, Logger and Level fields match every call.
+type Template struct {
+	// Package is a glob matched against the import path, see MatchPackage.
+	Package string `json:"package"`
+	// Logger is a logger family: "log", "slog", "zap", "logr" or "logrus".
+	Logger string `json:"logger"`
+	// Level is a log level such as "error". Calls without a known level,
+	// such as log.Print, never match a template with a level.
+	Level string `json:"level"`
+	// Match is a regular expression the message must match.
+	Match string `json:"match"`
+	// NotMatch is a regular expression the message must not match.
+	NotMatch string `json:"not_match"`
+	// Explanation is quoted in diagnostics to tell why the convention exists.
+	Explanation string `json:"explanation"`
+}
+
+// CompiledTemplate is a Template with its regular expressions compiled.
+type CompiledTemplate struct {
+	Template
+	match, notMatch *regexp.Regexp
+}
+
+// Compile validates t and compiles its regular expressions.
+func (t Template) Compile() (*CompiledTemplate, error) {
+	if t.Match == "" && t.NotMatch == "" {
+		return nil, errors.New("template has neither match nor not_match")
+	}
+	if _, err := path.Match(strings.TrimSuffix(t.Package, "/..."), ""); err != nil {
+		return nil, fmt.Errorf("template package %q: %w", t.Package, err)
+	}
+	c := &CompiledTemplate{Template: t}
+	var err error
+	if t.Match != "" {
+		if c.match, err = regexp.Compile(t.Match); err != nil {
+			return nil, fmt.Errorf("template match: %w", err)
+		}
+	}
+	if t.NotMatch != "" {
+		if c.notMatch, err = regexp.Compile(t.NotMatch); err != nil {
+			return nil, fmt.Errorf("template not_match: %w", err)
+		}
+	}
+	return c, nil
+}
+
+// Applies reports whether the template selects a log call of the given
+// logger family and level in the package with import path pkgPath.
+func (t *CompiledTemplate) Applies(pkgPath, logger, level string) bool {
+	return (t.Logger == "" || t.Logger == logger) &&
+		(t.Level == "" || t.Level == level) &&
+		MatchPackage(t.Package, pkgPath)
+}
+
+// MatchPackage reports whether the import path pkgPath matches pattern, a
+// path.Match glob. The pattern may match the trailing path elements only, so
+// "internal/billing" matches "example.com/app/internal/billing", and a
+// pattern ending in "/..." matches subpackages as well. An empty pattern
+// matches every package.
+func MatchPackage(pattern, pkgPath string) bool {
+	if pattern == "" {
+		return true
+	}
+	pattern, sub := strings.CutSuffix(pattern, "/...")
+	elems := strings.Split(pkgPath, "/")
+	n := strings.Count(pattern, "/") + 1
+	for i := 0; i+n <= len(elems); i++ {
+		if !sub && i+n != len(elems) {
+			continue
+		}
+		if ok, _ := path.Match(pattern, strings.Join(elems[i:i+n], "/")); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckTemplate reports a resolved message that does not match t.Match or
+// matches t.NotMatch, quoting the explanation of the template.
+func CheckTemplate(pass *analysis.Pass, subject Subject, node ast.Node, msg string, t *CompiledTemplate) {
+	var problem string
+	switch {
+	case t.match != nil && !t.match.MatchString(msg):
+		problem = "does not match " + strconv.Quote(t.Match)
+	case t.notMatch != nil && t.notMatch.MatchString(msg):
+		problem = "must not match " + strconv.Quote(t.NotMatch)
+	default:
+		return
+	}
+	if t.Explanation != "" {
+		problem += ": " + t.Explanation
+	}
+	pass.Reportf(node.Pos(), "%s %s", subject, problem)
+}
@@ -0,0 +1,69 @@
+package rules
+
+import "testing"
+
+func TestMatchPackage(t *testing.T) {
+	tests := []struct {
+		pattern, pkgPath string
+		want             bool
+	}{
+		{"", "example.com/app", true},
+		{"example.com/app", "example.com/app", true},
+		{"internal/billing", "example.com/app/internal/billing", true},
+		{"internal/billing", "example.com/app/internal/billing/invoice", false},
+		{"internal/billing/...", "example.com/app/internal/billing/invoice", true},
+		{"internal/billing/...", "example.com/app/internal/billing", true},
+		{"billing", "example.com/app/internal/billingx", false},
+		{"example.com/*/billing", "example.com/app/billing", true},
+		{"*", "example.com/app", true},
+		{"cmd/*", "example.com/app/cmd/server", true},
+		{"cmd/*", "example.com/app/cmd", false},
+	}
+	for _, tc := range tests {
+		if got := MatchPackage(tc.pattern, tc.pkgPath); got != tc.want {
+			t.Errorf("MatchPackage(%q, %q) = %v, want %v", tc.pattern, tc.pkgPath, got, tc.want)
+		}
+	}
+}
+
+func TestTemplateCompile(t *testing.T) {
+	tests := []struct {
+		template Template
+		wantErr  bool
+	}{
+		{Template{Match: "^failed to "}, false},
+		{Template{NotMatch: "^billing:", Package: "internal/billing/..."}, false},
+		{Template{Level: "error"}, true},
+		{Template{Match: "("}, true},
+		{Template{NotMatch: "[a-"}, true},
+		{Template{Match: "x", Package: "["}, true},
+	}
+	for _, tc := range tests {
+		_, err := tc.template.Compile()
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%+v.Compile() error = %v, want error %v", tc.template, err, tc.wantErr)
+		}
+	}
+}
+
+func TestTemplateApplies(t *testing.T) {
+	c, err := Template{Logger: "slog", Level: "error", Package: "internal/...", Match: "x"}.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		pkgPath, logger, level string
+		want                   bool
+	}{
+		{"example.com/internal/db", "slog", "error", true},
+		{"example.com/internal/db", "zap", "error", false},
+		{"example.com/internal/db", "slog", "info", false},
+		{"example.com/internal/db", "slog", "", false},
+		{"example.com/cmd", "slog", "error", false},
+	}
+	for _, tc := range tests {
+		if got := c.Applies(tc.pkgPath, tc.logger, tc.level); got != tc.want {
+			t.Errorf("Applies(%q, %q, %q) = %v, want %v", tc.pkgPath, tc.logger, tc.level, got, tc.want)
+		}
+	}
+}
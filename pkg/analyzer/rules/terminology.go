@@ -0,0 +1,191 @@
+package rules
+
+import (
+	"go/ast"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// termMatch is an occurrence of a banned phrase in a message.
+type termMatch struct {
+	Start, End int
+	Phrase     string
+}
+
+// CheckTerminology reports banned phrases of terms found in msg as whole words,
+// ignoring case. terms maps a phrase to its preferred replacement, or to ""
+// if the phrase must be removed. The SuggestedFix edits the exact bytes of
+// the phrase in the literal, keeping an initial capital letter.
+func CheckTerminology(pass *analysis.Pass, subject Subject, msg string, lit *ast.BasicLit, terms map[string]string) {
+	for _, m := range findTerms(msg, terms) {
+		found := msg[m.Start:m.End]
+		replacement := matchCase(terms[m.Phrase], found)
+
+		start, end, fixable := m.Start, m.End, true
+		var message, fixMessage string
+		if replacement == "" {
+			message = string(subject) + " must not contain " + strconv.Quote(found)
+			fixMessage = "remove " + strconv.Quote(found)
+			start, end, fixable = removalSpan(msg, start, end)
+		} else {
+			message = string(subject) + " uses " + strconv.Quote(found) + ", use " + strconv.Quote(replacement)
+			fixMessage = "replace " + strconv.Quote(found) + " with " + strconv.Quote(replacement)
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:     litPos(lit, m.Start),
+			End:     litPos(lit, m.End),
+			Message: message,
+		}
+		if fixable {
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message: fixMessage,
+				TextEdits: []analysis.TextEdit{{
+					Pos:     litPos(lit, start),
+					End:     litPos(lit, end),
+					NewText: []byte(escapeForLit(lit, replacement)),
+				}},
+			}}
+		}
+		pass.Report(diag)
+	}
+}
+
+// termSeparators are the punctuation marks removed together with a phrase
+// they follow, as in "TODO: handle errors".
+const termSeparators = ",:;-"
+
+// removalSpan returns the bytes of msg to delete to remove the phrase at
+// msg[start:end]: the phrase with a separator touching it and one space.
+// It reports false if no space separates the phrase from the rest of the
+// message, so removing it would leave stray punctuation.
+func removalSpan(msg string, start, end int) (int, int, bool) {
+	before, after := msg[:start], msg[end:]
+	switch {
+	case after != "" && strings.IndexByte(termSeparators, after[0]) >= 0 &&
+		(len(after) == 1 || after[1] == ' ') && (before == "" || strings.HasSuffix(before, " ")):
+		// "oops, retrying" -> "retrying", "retrying oops:" -> "retrying"
+		end++
+		if end < len(msg) {
+			end++
+		} else if before != "" {
+			start--
+		}
+		return start, end, true
+	case strings.HasSuffix(before, " ") && len(after) > 2 && after[0] == ' ' &&
+		strings.IndexByte(termSeparators, after[1]) >= 0 && after[2] == ' ':
+		// "failed - oops - retrying" -> "failed - retrying"
+		return start, end + 3, true
+	case strings.HasSuffix(before, " "):
+		start--
+		// "retrying, oops" -> "retrying"
+		if after == "" && start > 0 && strings.IndexByte(termSeparators, msg[start-1]) >= 0 {
+			start--
+		}
+		return start, end, true
+	case before == "" && (after == "" || after[0] == ' '):
+		if after != "" {
+			end++
+		}
+		return start, end, true
+	}
+	return 0, 0, false
+}
+
+// findTerms returns the non-overlapping whole-word occurrences of the phrases
+// of terms in msg, in order. Longer phrases win over phrases they contain.
+func findTerms(msg string, terms map[string]string) []termMatch {
+	phrases := make([]string, 0, len(terms))
+	for p := range terms {
+		if p != "" {
+			phrases = append(phrases, p)
+		}
+	}
+	sort.Slice(phrases, func(i, j int) bool {
+		if len(phrases[i]) != len(phrases[j]) {
+			return len(phrases[i]) > len(phrases[j])
+		}
+		return phrases[i] < phrases[j]
+	})
+
+	var matches []termMatch
+	taken := func(start, end int) bool {
+		for _, m := range matches {
+			if start < m.End && m.Start < end {
+				return true
+			}
+		}
+		return false
+	}
+	for _, p := range phrases {
+		for i := 0; i < len(msg); {
+			n, ok := foldPrefix(msg[i:], p)
+			if ok && wordBoundary(msg, i, i+n) && !taken(i, i+n) {
+				matches = append(matches, termMatch{Start: i, End: i + n, Phrase: p})
+				i += n
+				continue
+			}
+			_, size := utf8.DecodeRuneInString(msg[i:])
+			i += size
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Start < matches[j].Start })
+	return matches
+}
+
+// foldPrefix reports whether s starts with phrase under Unicode case folding
+// and returns the length of the matching prefix of s.
+func foldPrefix(s, phrase string) (int, bool) {
+	i := 0
+	for _, pr := range phrase {
+		if i >= len(s) {
+			return 0, false
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r != pr && !strings.EqualFold(string(r), string(pr)) {
+			return 0, false
+		}
+		i += size
+	}
+	return i, true
+}
+
+// wordBoundary reports whether msg[start:end] is not part of a longer word.
+func wordBoundary(msg string, start, end int) bool {
+	if r, _ := utf8.DecodeLastRuneInString(msg[:start]); start > 0 && isWordRune(r) {
+		return false
+	}
+	if r, _ := utf8.DecodeRuneInString(msg[end:]); end < len(msg) && isWordRune(r) {
+		return false
+	}
+	return true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// matchCase capitalizes replacement if found starts with an upper case letter.
+func matchCase(replacement, found string) string {
+	r, _ := utf8.DecodeRuneInString(found)
+	if replacement == "" || !unicode.IsUpper(r) {
+		return replacement
+	}
+	first, size := utf8.DecodeRuneInString(replacement)
+	return string(unicode.ToUpper(first)) + replacement[size:]
+}
+
+// escapeForLit returns text as it must be written inside lit: quoted and
+// without the surrounding quotes for interpreted strings, as is for raw strings.
+func escapeForLit(lit *ast.BasicLit, text string) string {
+	if strings.HasPrefix(lit.Value, "`") {
+		return text
+	}
+	q := strconv.Quote(text)
+	return q[1 : len(q)-1]
+}
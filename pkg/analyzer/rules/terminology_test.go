@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFindTerms(t *testing.T) {
+	terms := map[string]string{
+		"db":           "database",
+		"login":        "sign in",
+		"log in":       "sign in",
+		"successfully": "",
+	}
+	tests := []struct {
+		msg  string
+		want []string
+	}{
+		{"db connection failed", []string{"db"}},
+		{"DB connection failed", []string{"DB"}},
+		{"dbx and xdb are other words", nil},
+		{"user_db is an identifier", nil},
+		{"request processed successfully", []string{"successfully"}},
+		{"failed to log in", []string{"log in"}},
+		{"Login failed, db down", []string{"Login", "db"}},
+		{"ДБ db", []string{"db"}},
+	}
+	for _, tc := range tests {
+		var got []string
+		for _, m := range findTerms(tc.msg, terms) {
+			got = append(got, tc.msg[m.Start:m.End])
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("findTerms(%q) = %q, want %q", tc.msg, got, tc.want)
+		}
+	}
+}
+
+func TestRemovalSpan(t *testing.T) {
+	tests := []struct {
+		msg, phrase string
+		want        string
+		ok          bool
+	}{
+		{"request processed successfully", "successfully", "request processed", true},
+		{"successfully stopped worker", "successfully", "stopped worker", true},
+		{"oops, retrying", "oops", "retrying", true},
+		{"TODO: handle errors", "TODO", "handle errors", true},
+		{"failed - oops - retrying", "oops", "failed - retrying", true},
+		{"retrying, oops", "oops", "retrying", true},
+		{"retrying oops:", "oops", "retrying", true},
+		{"stopped worker successfully.", "successfully", "stopped worker.", true},
+		{"oops", "oops", "", true},
+		{"retrying (oops)", "oops", "", false},
+		{"oops! retrying", "oops", "", false},
+		{"retrying:oops", "oops", "", false},
+	}
+	for _, tc := range tests {
+		start := strings.Index(tc.msg, tc.phrase)
+		s, e, ok := removalSpan(tc.msg, start, start+len(tc.phrase))
+		if ok != tc.ok {
+			t.Errorf("removalSpan(%q, %q) ok = %v, want %v", tc.msg, tc.phrase, ok, tc.ok)
+			continue
+		}
+		if got := tc.msg[:s] + tc.msg[e:]; ok && got != tc.want {
+			t.Errorf("removing %q from %q = %q, want %q", tc.phrase, tc.msg, got, tc.want)
+		}
+	}
+}
+
+func TestMatchCase(t *testing.T) {
+	tests := []struct {
+		replacement, found, want string
+	}{
+		{"database", "db", "database"},
+		{"database", "DB", "Database"},
+		{"sign in", "Login", "Sign in"},
+		{"", "Oops", ""},
+	}
+	for _, tc := range tests {
+		if got := matchCase(tc.replacement, tc.found); got != tc.want {
+			t.Errorf("matchCase(%q, %q) = %q, want %q", tc.replacement, tc.found, got, tc.want)
+		}
+	}
+}
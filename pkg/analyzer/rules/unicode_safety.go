@@ -0,0 +1,182 @@
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// unsafeChar is a character that hides text or changes how a line renders.
+type unsafeChar struct {
+	kind string
+	name string
+}
+
+// unsafeChars lists the bidirectional controls and invisible characters of
+// Trojan Source attacks. Control characters are named by describeUnsafe.
+var unsafeChars = map[rune]unsafeChar{
+	0x061C: {"bidirectional control", "ARABIC LETTER MARK"},
+	0x200E: {"bidirectional control", "LEFT-TO-RIGHT MARK"},
+	0x200F: {"bidirectional control", "RIGHT-TO-LEFT MARK"},
+	0x202A: {"bidirectional control", "LEFT-TO-RIGHT EMBEDDING"},
+	0x202B: {"bidirectional control", "RIGHT-TO-LEFT EMBEDDING"},
+	0x202C: {"bidirectional control", "POP DIRECTIONAL FORMATTING"},
+	0x202D: {"bidirectional control", "LEFT-TO-RIGHT OVERRIDE"},
+	0x202E: {"bidirectional control", "RIGHT-TO-LEFT OVERRIDE"},
+	0x2066: {"bidirectional control", "LEFT-TO-RIGHT ISOLATE"},
+	0x2067: {"bidirectional control", "RIGHT-TO-LEFT ISOLATE"},
+	0x2068: {"bidirectional control", "FIRST STRONG ISOLATE"},
+	0x2069: {"bidirectional control", "POP DIRECTIONAL ISOLATE"},
+	0x00AD: {"invisible character", "SOFT HYPHEN"},
+	0x180E: {"invisible character", "MONGOLIAN VOWEL SEPARATOR"},
+	0x200B: {"invisible character", "ZERO WIDTH SPACE"},
+	0x200C: {"invisible character", "ZERO WIDTH NON-JOINER"},
+	0x200D: {"invisible character", "ZERO WIDTH JOINER"},
+	0x2060: {"invisible character", "WORD JOINER"},
+	0x2061: {"invisible character", "FUNCTION APPLICATION"},
+	0x2062: {"invisible character", "INVISIBLE TIMES"},
+	0x2063: {"invisible character", "INVISIBLE SEPARATOR"},
+	0x2064: {"invisible character", "INVISIBLE PLUS"},
+	0xFEFF: {"invisible character", "ZERO WIDTH NO-BREAK SPACE"},
+	0x2028: {"line break", "LINE SEPARATOR"},
+	0x2029: {"line break", "PARAGRAPH SEPARATOR"},
+}
+
+// c0Names are the abbreviations of the C0 control characters.
+var c0Names = [...]string{
+	"NUL", "SOH", "STX", "ETX", "EOT", "ENQ", "ACK", "BEL",
+	"BS", "HT", "LF", "VT", "FF", "CR", "SO", "SI",
+	"DLE", "DC1", "DC2", "DC3", "DC4", "NAK", "SYN", "ETB",
+	"CAN", "EM", "SUB", "ESC", "FS", "GS", "RS", "US",
+}
+
+// unsafeFinding is an unsafe character or escape sequence in a message.
+type unsafeFinding struct {
+	span
+	// description names the finding, e.g.
+	// "bidirectional control U+202E (RIGHT-TO-LEFT OVERRIDE)"
+	description string
+}
+
+// CheckUnicodeSafety reports bidirectional controls, invisible characters,
+// C0 and C1 control characters and ANSI escape sequences in msg, each with
+// a fix deleting exactly its bytes from the literal. Tabs, newlines and
+// carriage returns are left to the message-shape rule.
+func CheckUnicodeSafety(pass *analysis.Pass, subject Subject, msg string, lit *ast.BasicLit) {
+	for _, f := range unsafeFindings(msg) {
+		pos, end := litPos(lit, f.start), litPos(lit, f.end)
+		pass.Report(analysis.Diagnostic{
+			Pos:      pos,
+			End:      end,
+			Category: subject.Category(),
+			Message:  string(subject) + " must not contain " + f.description,
+			SuggestedFixes: []analysis.SuggestedFix{{
+				Message:   "remove " + f.description,
+				TextEdits: []analysis.TextEdit{{Pos: pos, End: end}},
+			}},
+		})
+	}
+}
+
+// CheckUnicodeSafetyConst is CheckUnicodeSafety for a constant that is not
+// a string literal, such as a named constant key. The findings are reported
+// at node without a fix.
+func CheckUnicodeSafetyConst(pass *analysis.Pass, subject Subject, node ast.Node, value string) {
+	for _, f := range unsafeFindings(value) {
+		pass.Report(analysis.Diagnostic{
+			Pos:      node.Pos(),
+			Category: subject.Category(),
+			Message:  string(subject) + " must not contain " + f.description,
+		})
+	}
+}
+
+// unsafeFindings returns the unsafe characters and escape sequences of msg.
+// Zero-width joiners and non-joiners between two non-ASCII characters are
+// accepted, they take part in emoji sequences and in scripts such as
+// Persian or Devanagari.
+func unsafeFindings(msg string) []unsafeFinding {
+	var findings []unsafeFinding
+	for i := 0; i < len(msg); {
+		if n := ansiSequenceLen(msg[i:]); n > 0 {
+			findings = append(findings, unsafeFinding{span{i, i + n},
+				"ANSI escape sequence " + strconv.Quote(msg[i:i+n])})
+			i += n
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(msg[i:])
+		if r == utf8.RuneError && size == 1 {
+			i++
+			continue
+		}
+		if description, ok := describeUnsafe(r); ok && !joinsScript(msg, i, i+size, r) {
+			findings = append(findings, unsafeFinding{span{i, i + size}, description})
+		}
+		i += size
+	}
+	return findings
+}
+
+// describeUnsafe returns the kind, code point and name of an unsafe rune.
+func describeUnsafe(r rune) (string, bool) {
+	var c unsafeChar
+	switch {
+	case r == '\t' || r == '\n' || r == '\r':
+		return "", false
+	case r < 0x20:
+		c = unsafeChar{"control character", c0Names[r]}
+	case r == 0x7F:
+		c = unsafeChar{"control character", "DEL"}
+	case r >= 0x80 && r <= 0x9F:
+		c = unsafeChar{"control character", "C1 CONTROL"}
+	default:
+		var ok bool
+		if c, ok = unsafeChars[r]; !ok {
+			return "", false
+		}
+	}
+	return fmt.Sprintf("%s %U (%s)", c.kind, r, c.name), true
+}
+
+// joinsScript reports whether the zero-width joiner or non-joiner r at
+// msg[start:end] sits between two non-ASCII characters.
+func joinsScript(msg string, start, end int, r rune) bool {
+	if r != 0x200C && r != 0x200D {
+		return false
+	}
+	before, _ := utf8.DecodeLastRuneInString(msg[:start])
+	after, _ := utf8.DecodeRuneInString(msg[end:])
+	return start > 0 && end < len(msg) && before >= utf8.RuneSelf && after >= utf8.RuneSelf
+}
+
+// ansiSequenceLen returns the length of the ANSI escape sequence at the start
+// of s, 0 if there is none: a CSI sequence such as "\x1b[31m", or an OSC
+// sequence such as a terminal title or hyperlink, ended by BEL or ESC \.
+func ansiSequenceLen(s string) int {
+	if len(s) < 2 || s[0] != 0x1b {
+		return 0
+	}
+	switch s[1] {
+	case '[':
+		i := 2
+		for i < len(s) && s[i] >= 0x20 && s[i] <= 0x3F {
+			i++ // parameter and intermediate bytes
+		}
+		if i < len(s) && s[i] >= 0x40 && s[i] <= 0x7E {
+			return i + 1
+		}
+	case ']':
+		for i := 2; i < len(s); i++ {
+			switch {
+			case s[i] == 0x07:
+				return i + 1
+			case s[i] == 0x1b && i+1 < len(s) && s[i+1] == '\\':
+				return i + 2
+			}
+		}
+	}
+	return 0
+}
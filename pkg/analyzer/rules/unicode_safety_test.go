@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUnsafeFindings(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want []string
+	}{
+		{"server started", nil},
+		{"tab\tnewline\ncr\r", nil},
+		{"a\u202eb", []string{"bidirectional control U+202E (RIGHT-TO-LEFT OVERRIDE)"}},
+		{"a\u200bb\u2060", []string{"invisible character U+200B (ZERO WIDTH SPACE)", "invisible character U+2060 (WORD JOINER)"}},
+		{"x\x1b", []string{"control character U+001B (ESC)"}},
+		{"\x7f\u009b", []string{"control character U+007F (DEL)", "control character U+009B (C1 CONTROL)"}},
+		{"\x1b[1;31mred", []string{`ANSI escape sequence "\x1b[1;31m"`}},
+		{"\U0001F468\u200d\U0001F4BB", nil},
+		{"\u0645\u06cc\u200c\u062e\u0648\u0627\u0647\u0645", nil},
+		{"id\u200d", []string{"invisible character U+200D (ZERO WIDTH JOINER)"}},
+		{"line\u2028break", []string{"line break U+2028 (LINE SEPARATOR)"}},
+		{"bad\xffutf8", nil},
+	}
+	for _, tc := range tests {
+		var got []string
+		for _, f := range unsafeFindings(tc.msg) {
+			got = append(got, f.description)
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("unsafeFindings(%q) = %q, want %q", tc.msg, got, tc.want)
+		}
+	}
+}
+
+func TestANSISequenceLen(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"\x1b[0m rest", 4},
+		{"\x1b[38;5;196mx", 11},
+		{"\x1b]8;;https://example.com\x1b\\link", 26},
+		{"\x1b]0;title\a", 10},
+		{"\x1b]0;unterminated", 0},
+		{"\x1b[31", 0},
+		{"\x1bc", 0},
+		{"plain", 0},
+	}
+	for _, tc := range tests {
+		if got := ansiSequenceLen(tc.s); got != tc.want {
+			t.Errorf("ansiSequenceLen(%q) = %d, want %d", tc.s, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package rules
+
+import "strings"
+
+// wrapVerb is the fmt verb that wraps an error. %v and %s flatten the error
+// into text and are not treated as wrapping.
+const wrapVerb = "%w"
+
+// WrapPrefixLen returns the byte length of a leading wrapping prefix such as
+// "%w: " in an error format string, or 0 if there is none.
+// The text after the prefix is the part that style rules apply to.
+func WrapPrefixLen(format string) int {
+	if !strings.HasPrefix(format, wrapVerb) {
+		return 0
+	}
+	rest := format[len(wrapVerb):]
+	sep := len(rest) - len(strings.TrimLeft(rest, ": "))
+	if sep == 0 {
+		return 0
+	}
+	return len(wrapVerb) + sep
+}
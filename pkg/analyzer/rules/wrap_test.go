@@ -0,0 +1,26 @@
+package rules
+
+import "testing"
+
+func TestWrapPrefixLen(t *testing.T) {
+	tests := []struct {
+		format string
+		want   int
+	}{
+		{"%w: Invalid id", 4},
+		{"%v: bad input", 0},
+		{"%s: bad input", 0},
+		{"%w:bad input", 3},
+		{"read config: %w", 0},
+		{"%water", 0},
+		{"%d items", 0},
+		{"", 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			if got := WrapPrefixLen(tc.format); got != tc.want {
+				t.Errorf("WrapPrefixLen(%q) = %d, want %d", tc.format, got, tc.want)
+			}
+		})
+	}
+}
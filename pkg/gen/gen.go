@@ -0,0 +1,357 @@
+// Package gen generates typed logging helpers from a log event schema.
+//
+// Every event becomes a function that logs it with slog, taking the required
+// attributes as typed parameters and the optional ones as functional options:
+//
+//	func OrderPlaced(ctx context.Context, logger *slog.Logger, orderID string, amount int64, opts ...OrderPlacedOption)
+//	func OrderPlacedCoupon(coupon string) OrderPlacedOption
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/idakhno/golangster/pkg/schema"
+)
+
+// Options configures the generated file.
+type Options struct {
+	// Package is the name of the generated package.
+	Package string
+	// Source names the schema in the generated header, e.g. "events.json".
+	Source string
+}
+
+// goType describes a Go parameter type and the slog constructor logging it.
+type goType struct {
+	kind        string
+	constructor string
+}
+
+// goTypes lists the parameter types supported in the schema "type" field.
+var goTypes = map[string]goType{
+	"string":        {schema.KindString, "String"},
+	"int":           {schema.KindNumber, "Int"},
+	"int64":         {schema.KindNumber, "Int64"},
+	"uint64":        {schema.KindNumber, "Uint64"},
+	"float64":       {schema.KindNumber, "Float64"},
+	"bool":          {schema.KindBool, "Bool"},
+	"time.Duration": {schema.KindDuration, "Duration"},
+	"time.Time":     {schema.KindAny, "Time"},
+	"any":           {schema.KindAny, "Any"},
+}
+
+// defaultTypes maps a value kind to the parameter type used if the schema
+// does not set one.
+var defaultTypes = map[string]string{
+	schema.KindString:   "string",
+	schema.KindNumber:   "int64",
+	schema.KindBool:     "bool",
+	schema.KindDuration: "time.Duration",
+	schema.KindAny:      "any",
+	"":                  "any",
+}
+
+// slogLevels maps schema levels to slog level constants.
+// slog has no fatal or panic level.
+var slogLevels = map[string]string{
+	"debug": "slog.LevelDebug",
+	"info":  "slog.LevelInfo",
+	"warn":  "slog.LevelWarn",
+	"error": "slog.LevelError",
+}
+
+// initialisms are words written in upper case inside Go identifiers.
+var initialisms = map[string]bool{
+	"api": true, "http": true, "https": true, "id": true, "ip": true,
+	"json": true, "sql": true, "tls": true, "uri": true, "url": true,
+	"uuid": true, "xml": true,
+}
+
+// reservedNames are identifiers used by the generated code that parameters
+// must not shadow.
+var reservedNames = map[string]bool{
+	"ctx": true, "logger": true, "level": true, "opts": true, "opt": true, "o": true,
+	"context": true, "slog": true, "time": true, "any": true,
+}
+
+type file struct {
+	Options
+	Time   bool
+	Events []event
+}
+
+type event struct {
+	Name    string
+	Message string
+	// Level is the slog level constant, "" if the level is a parameter.
+	Level     string
+	LevelName string
+	Required  []param
+	Optional  []param
+	// Options is the unexported struct holding the optional attributes.
+	Options string
+}
+
+type param struct {
+	Key         string
+	Name        string
+	Type        string
+	Constructor string
+	// Option is the name of the option function of an optional attribute.
+	Option string
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by golangster gen{{if .Source}} from {{.Source}}{{end}}. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+	"log/slog"
+{{- if .Time}}
+	"time"
+{{- end}}
+)
+{{range $e := .Events}}
+// {{.Name}} logs the {{printf "%q" .Message}} event{{if .Level}} at {{.LevelName}} level{{end}}.
+func {{.Name}}(ctx context.Context, logger *slog.Logger{{if not .Level}}, level slog.Level{{end}}
+{{- range .Required}}, {{.Name}} {{.Type}}{{end}}
+{{- if .Optional}}, opts ...{{.Name}}Option{{end}}) {
+{{- if .Optional}}
+	var o {{.Options}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+{{- end}}
+	logger.LogAttrs(ctx, {{or .Level "level"}}, {{printf "%q" .Message}},
+{{- range .Required}}
+		slog.{{.Constructor}}({{printf "%q" .Key}}, {{.Name}}),
+{{- end}}
+{{- range .Optional}}
+		o.{{.Name}},
+{{- end}}
+	)
+}
+{{- if .Optional}}
+
+// {{.Name}}Option sets an optional attribute of the {{printf "%q" .Message}} event.
+type {{.Name}}Option func(*{{.Options}})
+
+type {{.Options}} struct {
+{{- range .Optional}}
+	{{.Name}} slog.Attr
+{{- end}}
+}
+{{- range .Optional}}
+
+// {{.Option}} sets the optional {{printf "%q" .Key}} attribute of the {{printf "%q" $e.Message}} event.
+func {{.Option}}({{.Name}} {{.Type}}) {{$e.Name}}Option {
+	return func(o *{{$e.Options}}) {
+		o.{{.Name}} = slog.{{.Constructor}}({{printf "%q" .Key}}, {{.Name}})
+	}
+}
+{{- end}}
+{{- end}}
+{{end -}}
+`))
+
+// Generate returns the formatted source of a package with one function per
+// event of s.
+func Generate(s *schema.Schema, opts Options) ([]byte, error) {
+	if !token.IsIdentifier(opts.Package) {
+		return nil, fmt.Errorf("invalid package name %q", opts.Package)
+	}
+
+	f := file{Options: opts}
+	names := make(map[string]string)
+	declare := func(name, what string) error {
+		if prev, ok := names[name]; ok {
+			return fmt.Errorf("%s and %s are both generated as %s, set distinct event names", prev, what, name)
+		}
+		names[name] = what
+		return nil
+	}
+
+	for _, se := range s.Events {
+		e, err := newEvent(se)
+		if err != nil {
+			return nil, fmt.Errorf("event %q: %w", se.Message, err)
+		}
+		what := fmt.Sprintf("event %q", e.Message)
+		if err := declare(e.Name, what); err != nil {
+			return nil, err
+		}
+		if len(e.Optional) > 0 {
+			if err := declare(e.Name+"Option", what); err != nil {
+				return nil, err
+			}
+			if err := declare(e.Options, what); err != nil {
+				return nil, err
+			}
+		}
+		for _, p := range e.Optional {
+			if err := declare(p.Option, fmt.Sprintf("attribute %q of %s", p.Key, what)); err != nil {
+				return nil, err
+			}
+		}
+		for _, p := range append(e.Required, e.Optional...) {
+			if strings.HasPrefix(p.Type, "time.") {
+				f.Time = true
+			}
+		}
+		f.Events = append(f.Events, e)
+	}
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, f); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w", err)
+	}
+	return src, nil
+}
+
+// newEvent prepares an event for the template.
+func newEvent(se schema.Event) (event, error) {
+	e := event{Name: se.Name, Message: se.Message, LevelName: se.Level}
+	if e.Name == "" {
+		e.Name = exportedName(se.Message)
+	}
+	if !token.IsIdentifier(e.Name) || !token.IsExported(e.Name) {
+		return event{}, fmt.Errorf("%q is not an exported Go identifier, set the event name", e.Name)
+	}
+	e.Options = unexportedName(e.Name) + "Options"
+
+	if se.Level != "" {
+		level, ok := slogLevels[se.Level]
+		if !ok {
+			return event{}, fmt.Errorf("level %q is not supported by slog", se.Level)
+		}
+		e.Level = level
+	}
+
+	seen := make(map[string]string)
+	eventParam := func(a schema.Attribute) (param, error) {
+		p, err := newParam(a)
+		if err != nil {
+			return param{}, err
+		}
+		if prev, ok := seen[p.Name]; ok {
+			return param{}, fmt.Errorf("attributes %q and %q are both generated as %s", prev, a.Key, p.Name)
+		}
+		seen[p.Name] = a.Key
+		p.Option = e.Name + exportedName(a.Key)
+		return p, nil
+	}
+	for _, a := range se.Required {
+		p, err := eventParam(a)
+		if err != nil {
+			return event{}, err
+		}
+		e.Required = append(e.Required, p)
+	}
+	for _, a := range se.Optional {
+		p, err := eventParam(a)
+		if err != nil {
+			return event{}, err
+		}
+		e.Optional = append(e.Optional, p)
+	}
+	return e, nil
+}
+
+// newParam returns the parameter of an attribute.
+func newParam(a schema.Attribute) (param, error) {
+	typ := a.Type
+	if typ == "" {
+		typ = defaultTypes[a.Kind]
+	}
+	t, ok := goTypes[typ]
+	if !ok {
+		return param{}, fmt.Errorf("attribute %q: unsupported type %q", a.Key, typ)
+	}
+	if a.Kind != "" && a.Kind != schema.KindAny && t.kind != a.Kind {
+		return param{}, fmt.Errorf("attribute %q: type %s does not match kind %s", a.Key, typ, a.Kind)
+	}
+
+	name := unexportedName(a.Key)
+	if name == "" {
+		return param{}, fmt.Errorf("attribute %q: no Go name can be derived from the key", a.Key)
+	}
+	if token.IsKeyword(name) || reservedNames[name] {
+		name += "Value"
+	}
+	return param{Key: a.Key, Name: name, Type: typ, Constructor: t.constructor}, nil
+}
+
+// exportedName converts text such as "order placed" or "order_id"
+// to an exported Go identifier: "OrderPlaced", "OrderID".
+func exportedName(text string) string {
+	var b strings.Builder
+	for _, w := range words(text) {
+		b.WriteString(upperWord(w))
+	}
+	name := b.String()
+	if name != "" && !unicode.IsLetter(rune(name[0])) {
+		name = "Event" + name
+	}
+	return name
+}
+
+// unexportedName converts text to an unexported Go identifier: "orderID".
+func unexportedName(text string) string {
+	ws := words(text)
+	if len(ws) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(strings.ToLower(ws[0]))
+	for _, w := range ws[1:] {
+		b.WriteString(upperWord(w))
+	}
+	name := b.String()
+	if !unicode.IsLetter(rune(name[0])) {
+		name = "v" + name
+	}
+	return name
+}
+
+// upperWord capitalizes a word, or upper-cases it entirely if it is an initialism.
+func upperWord(w string) string {
+	w = strings.ToLower(w)
+	if initialisms[w] {
+		return strings.ToUpper(w)
+	}
+	return strings.ToUpper(w[:1]) + w[1:]
+}
+
+// words splits text on non-alphanumeric ASCII characters and lower-to-upper
+// case changes: "userID.value" -> ["user", "ID", "value"].
+func words(text string) []string {
+	var ws []string
+	start := -1
+	for i := 0; i <= len(text); i++ {
+		var c byte
+		if i < len(text) {
+			c = text[i]
+		}
+		alnum := c < unicode.MaxASCII && (unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c)))
+		boundary := alnum && start >= 0 && unicode.IsLower(rune(text[i-1])) && unicode.IsUpper(rune(c))
+		if start >= 0 && (!alnum || boundary) {
+			ws = append(ws, text[start:i])
+			start = -1
+		}
+		if alnum && start < 0 {
+			start = i
+		}
+	}
+	return ws
+}
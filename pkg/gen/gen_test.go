@@ -0,0 +1,79 @@
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/idakhno/golangster/pkg/schema"
+)
+
+// The generated testdata package is also checked by the analyzer tests.
+var testdataPkg = filepath.Join("..", "..", "testdata", "src", "logevents")
+
+func TestGenerate(t *testing.T) {
+	s, err := schema.Load(filepath.Join(testdataPkg, "events.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Generate(s, Options{Package: "logevents", Source: "events.json"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile(filepath.Join(testdataPkg, "events_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("generated code differs from events_gen.go, run go generate in %s:\n%s", testdataPkg, got)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   string
+	}{
+		{"level", `{"events": [{"message": "crashed", "level": "fatal"}]}`, `level "fatal" is not supported`},
+		{"type", `{"events": [{"message": "x", "required": [{"key": "id", "type": "uuid.UUID"}]}]}`, `unsupported type "uuid.UUID"`},
+		{"kind", `{"events": [{"message": "x", "required": [{"key": "id", "kind": "string", "type": "int"}]}]}`, "does not match kind string"},
+		{"name", `{"events": [{"message": "x", "name": "orderPlaced"}]}`, "not an exported Go identifier"},
+		{"params", `{"events": [{"message": "x", "required": [{"key": "user_id"}, {"key": "userID"}]}]}`, "both generated as userID"},
+		{"events", `{"events": [{"message": "order placed"}, {"message": "order_placed"}]}`, "both generated as OrderPlaced"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := schema.Parse([]byte(tc.schema))
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = Generate(s, Options{Package: "logevents"})
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("Generate error = %v, want %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		text, exported, unexported string
+	}{
+		{"order placed", "OrderPlaced", "orderPlaced"},
+		{"order_id", "OrderID", "orderID"},
+		{"http.url", "HTTPURL", "httpURL"},
+		{"userID", "UserID", "userID"},
+		{"2fa enabled", "Event2faEnabled", "v2faEnabled"},
+		{"...", "", ""},
+	}
+	for _, tc := range tests {
+		if got := exportedName(tc.text); got != tc.exported {
+			t.Errorf("exportedName(%q) = %q, want %q", tc.text, got, tc.exported)
+		}
+		if got := unexportedName(tc.text); got != tc.unexported {
+			t.Errorf("unexportedName(%q) = %q, want %q", tc.text, got, tc.unexported)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Package schema loads log event schemas: the list of events a program may
+// log, with their message, level and typed attributes.
+//
+// A schema is a JSON or YAML document:
+//
+//	{
+//	  "events": [
+//	    {
+//	      "name": "OrderPlaced",
+//	      "message": "order placed",
+//	      "level": "info",
+//	      "required": [
+//	        {"key": "order_id", "kind": "string"},
+//	        {"key": "amount", "kind": "number", "type": "int64"}
+//	      ],
+//	      "optional": [
+//	        {"key": "coupon", "kind": "string"}
+//	      ]
+//	    }
+//	  ]
+//	}
+//
+// or, in a file named .yaml or .yml:
+//
+//	events:
+//	  - name: OrderPlaced
+//	    message: order placed
+//	    level: info
+//	    required:
+//	      - {key: order_id, kind: string}
+//	      - {key: amount, kind: number, type: int64}
+//	    optional:
+//	      - {key: coupon, kind: string}
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Value kinds of attributes, shared with the key-types rule. KindAny accepts
+// every value.
+const (
+	KindString   = "string"
+	KindNumber   = "number"
+	KindBool     = "bool"
+	KindDuration = "duration"
+	KindAny      = "any"
+)
+
+// Levels that an event may declare. An empty level accepts every level.
+var Levels = []string{"debug", "info", "warn", "error", "fatal", "panic"}
+
+// Schema is a set of log events.
+type Schema struct {
+	Events []Event `json:"events" yaml:"events"`
+
+	byMessage map[string][]*Event
+}
+
+// Event is a log event declared in a schema.
+type Event struct {
+	// Name is an optional identifier, used by code generation.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	// Message is the constant log message.
+	Message string `json:"message" yaml:"message"`
+	// Level is the level the event is logged at, or "" for any level.
+	Level string `json:"level,omitempty" yaml:"level,omitempty"`
+	// Required attributes must be present on every log call.
+	Required []Attribute `json:"required,omitempty" yaml:"required,omitempty"`
+	// Optional attributes may be present.
+	Optional []Attribute `json:"optional,omitempty" yaml:"optional,omitempty"`
+}
+
+// Attribute is an attribute of an event.
+type Attribute struct {
+	// Key is the attribute key, qualified with its groups, e.g. "http.method".
+	Key string `json:"key" yaml:"key"`
+	// Kind is the value kind: string, number, bool, duration or any.
+	Kind string `json:"kind,omitempty" yaml:"kind,omitempty"`
+	// Type is the Go type of the parameter in generated code, e.g. "float64".
+	// If empty, it is derived from Kind.
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+}
+
+// Load reads and validates the schema file at path. Files named .yaml or
+// .yml are YAML, all others JSON.
+func Load(path string) (*Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read schema: %w", err)
+	}
+	parse := Parse
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+		parse = ParseYAML
+	}
+	s, err := parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("schema %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Parse decodes and validates a JSON schema.
+func Parse(data []byte) (*Schema, error) {
+	var s Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	if err := s.init(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// ParseYAML decodes and validates a YAML schema.
+func ParseYAML(data []byte) (*Schema, error) {
+	var s Schema
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	if err := s.init(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// init validates the events and indexes them by message.
+func (s *Schema) init() error {
+	s.byMessage = make(map[string][]*Event, len(s.Events))
+	for i := range s.Events {
+		e := &s.Events[i]
+		if e.Message == "" {
+			return fmt.Errorf("event %d: message is required", i)
+		}
+		if e.Level != "" && !validLevel(e.Level) {
+			return fmt.Errorf("event %q: unknown level %q", e.Message, e.Level)
+		}
+		seen := make(map[string]bool)
+		for _, a := range e.Attributes() {
+			if a.Key == "" {
+				return fmt.Errorf("event %q: attribute key is required", e.Message)
+			}
+			if seen[a.Key] {
+				return fmt.Errorf("event %q: duplicate attribute %q", e.Message, a.Key)
+			}
+			seen[a.Key] = true
+			if !validKind(a.Kind) {
+				return fmt.Errorf("event %q: attribute %q has unknown kind %q", e.Message, a.Key, a.Kind)
+			}
+		}
+		s.byMessage[e.Message] = append(s.byMessage[e.Message], e)
+	}
+	return nil
+}
+
+// Lookup returns the events declared with message.
+func (s *Schema) Lookup(message string) []*Event {
+	return s.byMessage[message]
+}
+
+// Attributes returns the required attributes followed by the optional ones.
+func (e *Event) Attributes() []Attribute {
+	return append(append([]Attribute(nil), e.Required...), e.Optional...)
+}
+
+// Attribute returns the attribute declared with key and whether it is required.
+func (e *Event) Attribute(key string) (Attribute, bool, bool) {
+	for _, a := range e.Required {
+		if a.Key == key {
+			return a, true, true
+		}
+	}
+	for _, a := range e.Optional {
+		if a.Key == key {
+			return a, false, true
+		}
+	}
+	return Attribute{}, false, false
+}
+
+func validLevel(level string) bool {
+	for _, l := range Levels {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+func validKind(kind string) bool {
+	switch kind {
+	case "", KindString, KindNumber, KindBool, KindDuration, KindAny:
+		return true
+	}
+	return false
+}
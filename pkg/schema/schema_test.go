@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	s, err := Parse([]byte(`{"events": [
+		{"message": "order placed", "level": "info",
+		 "required": [{"key": "order_id", "kind": "string"}],
+		 "optional": [{"key": "coupon"}]},
+		{"message": "order placed", "level": "debug"}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := s.Lookup("order placed")
+	if len(events) != 2 {
+		t.Fatalf("Lookup returned %d events, want 2", len(events))
+	}
+	if a, required, ok := events[0].Attribute("order_id"); !ok || !required || a.Kind != KindString {
+		t.Errorf("Attribute(order_id) = %+v, %v, %v", a, required, ok)
+	}
+	if _, required, ok := events[0].Attribute("coupon"); !ok || required {
+		t.Errorf("Attribute(coupon) = %v, %v, want optional", required, ok)
+	}
+	if _, _, ok := events[0].Attribute("user"); ok {
+		t.Error("Attribute(user) found an undeclared attribute")
+	}
+	if got := s.Lookup("order shipped"); got != nil {
+		t.Errorf("Lookup(order shipped) = %v, want nil", got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   string
+	}{
+		{"syntax", `{"events": [`, "unexpected end"},
+		{"no message", `{"events": [{"level": "info"}]}`, "message is required"},
+		{"level", `{"events": [{"message": "x", "level": "notice"}]}`, `unknown level "notice"`},
+		{"no key", `{"events": [{"message": "x", "required": [{"kind": "string"}]}]}`, "attribute key is required"},
+		{"kind", `{"events": [{"message": "x", "required": [{"key": "id", "kind": "uuid"}]}]}`, `unknown kind "uuid"`},
+		{"duplicate", `{"events": [{"message": "x", "required": [{"key": "id"}], "optional": [{"key": "id"}]}]}`, `duplicate attribute "id"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse([]byte(tc.schema))
+			if err == nil || !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("Parse error = %v, want %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseYAML(t *testing.T) {
+	s, err := ParseYAML([]byte(`
+events:
+  - name: OrderPlaced
+    message: order placed
+    level: info
+    required:
+      - {key: order_id, kind: string}
+      - {key: amount, kind: number, type: int64}
+    optional:
+      - key: coupon
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := s.Lookup("order placed")
+	if len(events) != 1 || events[0].Name != "OrderPlaced" || events[0].Level != "info" {
+		t.Fatalf("Lookup(order placed) = %+v", events)
+	}
+	if a, required, ok := events[0].Attribute("amount"); !ok || !required || a.Kind != KindNumber || a.Type != "int64" {
+		t.Errorf("Attribute(amount) = %+v, %v, %v", a, required, ok)
+	}
+	if _, required, ok := events[0].Attribute("coupon"); !ok || required {
+		t.Errorf("Attribute(coupon) = %v, %v, want optional", required, ok)
+	}
+
+	if _, err := ParseYAML([]byte("events:\n  - level: info\n")); err == nil || !strings.Contains(err.Error(), "message is required") {
+		t.Errorf("ParseYAML error = %v, want message is required", err)
+	}
+	if _, err := ParseYAML([]byte("events: [")); err == nil {
+		t.Error("ParseYAML accepted invalid YAML")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"events.json": `{"events": [{"message": "order placed"}]}`,
+		"events.yaml": "events:\n  - message: order placed\n",
+		"events.yml":  "events:\n  - message: order placed\n",
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		s, err := Load(path)
+		if err != nil {
+			t.Errorf("Load(%s): %v", name, err)
+			continue
+		}
+		if len(s.Lookup("order placed")) != 1 {
+			t.Errorf("Load(%s) lost the event", name)
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"golang.org/x/tools/go/analysis"
 
 	"github.com/idakhno/golangster/pkg/analyzer"
+	"github.com/idakhno/golangster/pkg/analyzer/rules"
 )
 
 // New is the entry point for the golangci-lint plugin system.
@@ -34,12 +35,66 @@ func New(conf any) ([]*analysis.Analyzer, error) {
 			if v, ok := rules["english_only"].(bool); ok {
 				cfg.Rules.EnglishOnly = v
 			}
+			if v, ok := rules["english_language"].(bool); ok {
+				cfg.Rules.EnglishLanguage = v
+			}
 			if v, ok := rules["no_special_chars"].(bool); ok {
 				cfg.Rules.NoSpecialChars = v
 			}
 			if v, ok := rules["no_sensitive"].(bool); ok {
 				cfg.Rules.NoSensitive = v
 			}
+			if v, ok := rules["format_mismatch"].(bool); ok {
+				cfg.Rules.FormatMismatch = v
+			}
+			if v, ok := rules["message_shape"].(bool); ok {
+				cfg.Rules.MessageShape = v
+			}
+			if v, ok := rules["message_length"].(bool); ok {
+				cfg.Rules.MessageLength = v
+			}
+			if v, ok := rules["terminology"].(bool); ok {
+				cfg.Rules.Terminology = v
+			}
+			if v, ok := rules["spelling"].(bool); ok {
+				cfg.Rules.Spelling = v
+			}
+			if v, ok := rules["template"].(bool); ok {
+				cfg.Rules.Template = v
+			}
+			if v, ok := rules["unicode_safety"].(bool); ok {
+				cfg.Rules.UnicodeSafety = v
+			}
+			if v, ok := rules["attribute_key_text"].(bool); ok {
+				cfg.Rules.AttributeKeyText = v
+			}
+			if v, ok := rules["group_name_text"].(bool); ok {
+				cfg.Rules.GroupNameText = v
+			}
+			if v, ok := rules["logger_name_text"].(bool); ok {
+				cfg.Rules.LoggerNameText = v
+			}
+			if v, ok := rules["static_message"].(bool); ok {
+				cfg.Rules.StaticMessage = v
+			}
+			if v, ok := rules["sprintf_message"].(bool); ok {
+				cfg.Rules.SprintfMessage = v
+			}
+			if v, ok := rules["key_style"].(bool); ok {
+				cfg.Rules.KeyStyle = v
+			}
+			if v, ok := rules["key_collisions"].(bool); ok {
+				cfg.Rules.KeyCollisions = v
+			}
+			if v, ok := rules["dynamic_key"].(bool); ok {
+				cfg.Rules.DynamicKey = v
+			}
+			if v, ok := rules["key_types"].(bool); ok {
+				cfg.Rules.KeyTypes = v
+			}
+			if v, ok := rules["error_strings"].(bool); ok {
+				cfg.Rules.ErrorStrings = v
+			}
 		}
 		if kws, ok := settings["sensitive_keywords"].([]any); ok {
 			for _, kw := range kws {
@@ -48,7 +103,163 @@ func New(conf any) ([]*analysis.Analyzer, error) {
 				}
 			}
 		}
+		if v, ok := settings["case_style"].(string); ok {
+			cfg.CaseStyle = rules.CaseStyle(v)
+		}
+		if styles, ok := settings["case_style_packages"].([]any); ok {
+			for _, v := range styles {
+				if m, ok := v.(map[string]any); ok {
+					pkg, _ := m["package"].(string)
+					style, _ := m["style"].(string)
+					cfg.CaseStyleByPackage = append(cfg.CaseStyleByPackage,
+						rules.PackageCaseStyle{Package: pkg, Style: rules.CaseStyle(style)})
+				}
+			}
+		}
+		cfg.ProperNouns = append(cfg.ProperNouns, stringSlice(settings["proper_nouns"])...)
+		if scripts := stringSlice(settings["english_scripts"]); len(scripts) > 0 {
+			cfg.EnglishScripts = scripts
+		}
+		if packages, ok := settings["english_scripts_packages"].([]any); ok {
+			for _, v := range packages {
+				if m, ok := v.(map[string]any); ok {
+					pkg, _ := m["package"].(string)
+					cfg.EnglishScriptsByPackage = append(cfg.EnglishScriptsByPackage,
+						rules.PackageScripts{Package: pkg, Scripts: stringSlice(m["scripts"])})
+				}
+			}
+		}
+		if v, ok := settings["english_threshold"]; ok {
+			cfg.EnglishThreshold = floatValue(v)
+		}
+		cfg.EnglishAllowlist = append(cfg.EnglishAllowlist, stringSlice(settings["english_allowlist"])...)
+		if chars, ok := settings["special_chars"].(map[string]any); ok {
+			cfg.SpecialChars = cfg.SpecialChars.Merge(charPolicy(chars))
+			if levels, ok := chars["levels"].(map[string]any); ok {
+				cfg.SpecialCharsByLevel = make(map[string]rules.CharPolicy, len(levels))
+				for level, v := range levels {
+					if policy, ok := v.(map[string]any); ok {
+						cfg.SpecialCharsByLevel[level] = charPolicy(policy)
+					}
+				}
+			}
+		}
+		cfg.ErrorStringExemptPrefixes = append(cfg.ErrorStringExemptPrefixes,
+			stringSlice(settings["error_string_exempt_prefixes"])...)
+		if v, ok := settings["key_style"].(string); ok {
+			cfg.KeyStyle = rules.KeyStyle(v)
+		}
+		cfg.KeyStyleExceptions = append(cfg.KeyStyleExceptions,
+			stringSlice(settings["key_style_exceptions"])...)
+		if v, ok := settings["key_registry"].(string); ok {
+			cfg.KeyRegistry = v
+		}
+		if v, ok := settings["schema"].(string); ok {
+			cfg.Schema = v
+		}
+		if length, ok := settings["message_length"].(map[string]any); ok {
+			cfg.MessageLength = cfg.MessageLength.Merge(lengthLimits(length))
+			if levels, ok := length["levels"].(map[string]any); ok {
+				cfg.MessageLengthByLevel = make(map[string]rules.LengthLimits, len(levels))
+				for level, v := range levels {
+					if limits, ok := v.(map[string]any); ok {
+						cfg.MessageLengthByLevel[level] = lengthLimits(limits)
+					}
+				}
+			}
+		}
+		if terms, ok := settings["terminology"].(map[string]any); ok {
+			cfg.Terminology = make(map[string]string, len(terms))
+			for phrase, v := range terms {
+				replacement, _ := v.(string)
+				cfg.Terminology[phrase] = replacement
+			}
+		}
+		cfg.SpellingWords = append(cfg.SpellingWords, stringSlice(settings["spelling_words"])...)
+		if v, ok := settings["spelling_dictionary"].(string); ok {
+			cfg.SpellingDictionary = v
+		}
+		if templates, ok := settings["templates"].([]any); ok {
+			for _, v := range templates {
+				if t, ok := v.(map[string]any); ok {
+					cfg.Templates = append(cfg.Templates, template(t))
+				}
+			}
+		}
+		if reserved, ok := settings["reserved_keys"].(map[string]any); ok {
+			cfg.ReservedKeys = make(map[string][]string, len(reserved))
+			for logger, keys := range reserved {
+				cfg.ReservedKeys[logger] = stringSlice(keys)
+			}
+		}
 	}
 
 	return []*analysis.Analyzer{analyzer.NewAnalyzer(cfg)}, nil
 }
+
+// stringSlice returns the strings of a YAML list setting, skipping other values.
+func stringSlice(v any) []string {
+	list, _ := v.([]any)
+	var out []string
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// lengthLimits reads max_chars, max_words and min_words from a YAML map.
+func lengthLimits(m map[string]any) rules.LengthLimits {
+	return rules.LengthLimits{
+		MaxChars: intValue(m["max_chars"]),
+		MaxWords: intValue(m["max_words"]),
+		MinWords: intValue(m["min_words"]),
+	}
+}
+
+// charPolicy reads forbidden, allowed and emoji from a YAML map.
+func charPolicy(m map[string]any) rules.CharPolicy {
+	forbidden, _ := m["forbidden"].(string)
+	allowed, _ := m["allowed"].(string)
+	return rules.CharPolicy{Forbidden: forbidden, Allowed: allowed, Emoji: stringSlice(m["emoji"])}
+}
+
+// template reads a template of the template rule from a YAML map.
+func template(m map[string]any) rules.Template {
+	str := func(key string) string {
+		s, _ := m[key].(string)
+		return s
+	}
+	return rules.Template{
+		Package:     str("package"),
+		Logger:      str("logger"),
+		Level:       str("level"),
+		Match:       str("match"),
+		NotMatch:    str("not_match"),
+		Explanation: str("explanation"),
+	}
+}
+
+// intValue returns the integer of a YAML or JSON number setting, 0 otherwise.
+func intValue(v any) int {
+	switch n := v.(type) {
+	case int:
+		return n
+	case int64:
+		return int(n)
+	case uint64:
+		return int(n)
+	case float64:
+		return int(n)
+	}
+	return 0
+}
+
+// floatValue returns the number of a YAML or JSON number setting, 0 otherwise.
+func floatValue(v any) float64 {
+	if f, ok := v.(float64); ok {
+		return f
+	}
+	return float64(intValue(v))
+}
@@ -0,0 +1,22 @@
+package case_style
+
+import (
+	"errors"
+	"log"
+	"log/slog"
+)
+
+type config struct{}
+
+func sentence(name string) error {
+	slog.Info("Starting server")
+	slog.Info("starting server")             // want `log message must start with an uppercase letter`
+	log.Printf("listening on %s", name)      // want `log message must start with an uppercase letter`
+	slog.Info("user " + name + " Logged in") // want `log message must start with an uppercase letter`
+	slog.Info("HTTP server started")
+	slog.Info("userID missing")
+	slog.Info("config loaded")
+	slog.Info("%s started", name)
+	slog.Info("\u00e9chec de connexion") // want `log message must start with an uppercase letter`
+	return errors.New("connection refused")
+}
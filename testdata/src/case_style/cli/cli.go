@@ -0,0 +1,8 @@
+package cli
+
+import "log/slog"
+
+func any() {
+	slog.Info("starting server")
+	slog.Info("Starting server")
+}
@@ -0,0 +1,9 @@
+package legacy
+
+import "log/slog"
+
+func lower() {
+	slog.Info("starting server")
+	slog.Info("Starting server") // want `log message must start with a lowercase letter`
+	slog.Info("HTTP server started")
+}
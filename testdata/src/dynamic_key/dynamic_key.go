@@ -0,0 +1,31 @@
+package dynamic_key
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+const keyCount = "count"
+
+func bad(name, id string, n, v int) {
+	slog.Info("stats", fmt.Sprintf("count_%s", name), n)             // want `attribute key must be a constant, group the values or log the variable part as a value attribute`
+	slog.Info("stats", slog.Int("shard_"+id, v))                     // want `attribute key must be a constant`
+	zap.L().Info("stats", zap.Int("shard_"+id, v))                   // want `attribute key must be a constant`
+	zap.L().Sugar().Infow("stats", "shard_"+id, v)                   // want `attribute key must be a constant`
+	slog.Default().With(name, v).Info("stats")                       // want `attribute key must be a constant`
+	logrus.WithFields(logrus.Fields{"shard_" + id: v}).Info("stats") // want `attribute key must be a constant`
+	logrus.WithField(name, v).Info("stats")                          // want `attribute key must be a constant`
+	fields := logrus.Fields{"count": n, name: v}                     // want `attribute key must be a constant`
+	logrus.WithFields(fields).Info("stats")
+}
+
+func good(id string, n int) {
+	slog.Info("stats", keyCount, n, "shard", id)
+	slog.Info("stats", slog.Group("shard", slog.String("id", id), slog.Int("count", n)))
+	zap.L().Info("stats", zap.String("shard", id), zap.Int("count", n))
+	logrus.WithFields(logrus.Fields{"shard": id, keyCount: n}).Info("stats")
+	slog.Info("stats", slog.Int("count", n), 42)
+}
@@ -15,4 +15,5 @@ func good() {
 	slog.Warn("system warning")
 	slog.Debug("request timeout after 30s")
 	slog.Info("user john@example.com created")
+	slog.Info("verbindung fehlgeschlagen") // language scoring is opt-in
 }
@@ -0,0 +1,35 @@
+package english_latin
+
+import "log/slog"
+
+func diacritics() {
+	slog.Info("conexi\u00f3n fallida")                // want `log message must be in English only, found "conexi\x{f3}n"`
+	slog.Info("k\u1ebft n\u1ed1i th\u1ea5t b\u1ea1i") // want `found "k\x{1ebf}t"` `found "n\x{1ed1}i"` `found "th\x{1ea5}t"` `found "b\x{1ea1}i"`
+	slog.Info("cafe\u0301 opened")                    // want `log message must be in English only, found "cafe\x{301}"`
+}
+
+func languages() {
+	slog.Info("Verbindung fehlgeschlagen")               // want `log message must be in English only, it reads as German \(confidence 1.00\)`
+	slog.Error("no se pudo conectar a la base de datos") // want `it reads as Spanish`
+	slog.Warn("impossible de se connecter au serveur")   // want `it reads as French`
+	slog.Info("verbinding met de server mislukt")        // want `it reads as Dutch \(confidence 0.60\)`
+}
+
+func allowed() {
+	slog.Info("order shipped to Z\u00fcrich")
+	slog.Info("Nestl\u00e9 account synced")
+	slog.Info("connected to Postgres")
+}
+
+func english() {
+	slog.Info("failed to connect to database")
+	slog.Info("kubelet restarted after eviction")
+	slog.Info("retrying request in the background")
+	slog.Info("user %s deleted the schedule")
+	slog.Info("chaos monkey")
+	slog.Info("chaos monkey enabled")
+	slog.Info("redis sentinel failover")
+	slog.Info("argocd sync completed")
+	slog.Info("kafka consumer rebalancing")
+	slog.Info("conexion fallida")
+}
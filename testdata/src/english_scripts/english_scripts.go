@@ -0,0 +1,16 @@
+package english_scripts
+
+import "log/slog"
+
+func scripts() {
+	slog.Info("\u09b8\u0982\u09af\u09cb\u0997 failed")                    // want `log message must be in English only, found Bengali script`
+	slog.Info("\u0b87\u0ba3\u0bc8\u0baa\u0bcd\u0baa\u0bc1 lost")          // want `found Tamil script`
+	slog.Info("\u13e3\u13b3\u13a9 user")                                  // want `found Cherokee script`
+	slog.Info("\u043e\u0448\u0438\u0431\u043a\u0430: \u670d\u52a1\u5668") // want `found Cyrillic script` `found Han script`
+	slog.Info("count \uff12")                                             // want `found Common script`
+}
+
+func good() {
+	slog.Info("request completed in 2s")
+	slog.Info("user created: john@example.com")
+}
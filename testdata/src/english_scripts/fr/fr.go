@@ -0,0 +1,9 @@
+package fr
+
+import "log/slog"
+
+func localized() {
+	slog.Info("\u00e9chec de connexion")
+	slog.Info("impossible de se connecter au serveur")
+	slog.Info("\u0441\u0435\u0440\u0432\u0435\u0440") // want `found Cyrillic script`
+}
@@ -0,0 +1,8 @@
+package ru
+
+import "log/slog"
+
+func localized() {
+	slog.Info("\u043e\u0448\u0438\u0431\u043a\u0430 \u043f\u043e\u0434\u043a\u043b\u044e\u0447\u0435\u043d\u0438\u044f")
+	slog.Info("\u03c3\u03c6\u03ac\u03bb\u03bc\u03b1") // want `found Greek script`
+}
@@ -0,0 +1,31 @@
+package error_strings
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errBase = errors.New("base failure")
+
+func bad(id int, token string) {
+	_ = errors.New("Connection refused")             // want `error string must start with a lowercase letter`
+	_ = fmt.Errorf("Failed to load user %d", id)     // want `error string must start with a lowercase letter`
+	_ = fmt.Errorf("%w: Invalid id %d", errBase, id) // want `error string must start with a lowercase letter`
+	_ = errors.New("ошибка подключения")             // want `error string must be in English only`
+	_ = errors.New("connection failed!")             // want `error string must not contain special character '!'`
+	_ = fmt.Errorf("invalid token: %s", token)       // want `error string may expose sensitive data \(keyword: "token"\)`
+	_ = status.Errorf(codes.NotFound, "User %d", id) // want `error string must start with a lowercase letter`
+	_ = status.Error(codes.Internal, "retrying...")  // want `error string must not contain '...' \(ellipsis\)`
+}
+
+func good(id int) {
+	_ = errors.New("connection refused")
+	_ = fmt.Errorf("load user %d: %w", id, errBase)
+	_ = fmt.Errorf("%w: invalid id %d", errBase, id)
+	_ = errors.New("EOF while reading header")
+	_ = fmt.Errorf("HTTP request failed: %w", errBase)
+	_ = status.New(codes.OK, "ok")
+}
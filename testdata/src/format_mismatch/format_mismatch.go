@@ -0,0 +1,36 @@
+package format_mismatch
+
+import (
+	"log"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func bad(name string, n int) {
+	sugar := zap.L().Sugar()
+
+	slog.Info("user %s logged in", name)           // want `Info does not support format verbs such as "%s", pass values as attributes`
+	zap.L().Info("%d items processed")             // want `Info does not support format verbs such as "%d", pass values as attributes`
+	log.Print("user %s logged in", name)           // want `Print does not support format verbs such as "%s", use Printf`
+	log.Println("processed %d items")              // want `Println does not support format verbs such as "%d", use Printf`
+	sugar.Infow("user %s logged in", "name", name) // want `Infow does not support format verbs such as "%s", use Infof`
+	sugar.Info("processed %d items", n)            // want `Info does not support format verbs such as "%d", use Infof`
+	sugar.Infof("user logged in", "name", name)    // want `Infof call has arguments but no format verbs, use Infow`
+	sugar.Errorf("request failed", n)              // want `Errorf call has arguments but no format verbs, use Error`
+	log.Printf("request failed", n)                // want `Printf call has arguments but no format verbs, use Print`
+	log.Printf("user %s has %d items", name)       // want `Printf format "user %s has %d items" reads 2 arguments, but call has 1`
+}
+
+func good(name string, n int, args []any) {
+	sugar := zap.L().Sugar()
+
+	slog.Info("user logged in", "name", name)
+	slog.Info("upload 100% done")
+	log.Printf("user %s has %d items", name, n)
+	log.Printf("%[1]s and %[1]s", name)
+	log.Printf("user %s", args...)
+	log.Printf("done")
+	sugar.Infof("processed %d items", n)
+	sugar.Infow("user logged in", "name", name)
+}
@@ -0,0 +1,11 @@
+// Package logr is a minimal stub of github.com/go-logr/logr for analysistest.
+package logr
+
+type Logger struct{}
+
+func Discard() Logger                                              { return Logger{} }
+func (l Logger) Info(msg string, keysAndValues ...any)             {}
+func (l Logger) Error(err error, msg string, keysAndValues ...any) {}
+func (l Logger) V(level int) Logger                                { return l }
+func (l Logger) WithValues(keysAndValues ...any) Logger            { return l }
+func (l Logger) WithName(name string) Logger                       { return l }
@@ -0,0 +1,70 @@
+// Package zap is a minimal stub of go.uber.org/zap for analysistest.
+package zap
+
+import (
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	DebugLevel = zapcore.DebugLevel
+	InfoLevel  = zapcore.InfoLevel
+	WarnLevel  = zapcore.WarnLevel
+	ErrorLevel = zapcore.ErrorLevel
+)
+
+type Field struct{}
+
+func String(key string, val string) Field                         { return Field{} }
+func Strings(key string, val []string) Field                      { return Field{} }
+func Int(key string, val int) Field                               { return Field{} }
+func Int64(key string, val int64) Field                           { return Field{} }
+func Uint(key string, val uint) Field                             { return Field{} }
+func Uint64(key string, val uint64) Field                         { return Field{} }
+func Float64(key string, val float64) Field                       { return Field{} }
+func Bool(key string, val bool) Field                             { return Field{} }
+func Duration(key string, val time.Duration) Field                { return Field{} }
+func Time(key string, val time.Time) Field                        { return Field{} }
+func Error(err error) Field                                       { return Field{} }
+func NamedError(key string, err error) Field                      { return Field{} }
+func Stringer(key string, val interface{ String() string }) Field { return Field{} }
+func Any(key string, value interface{}) Field                     { return Field{} }
+func Namespace(key string) Field                                  { return Field{} }
+
+type Logger struct{}
+
+func NewNop() *Logger                                                  { return &Logger{} }
+func L() *Logger                                                       { return &Logger{} }
+func (log *Logger) Debug(msg string, fields ...Field)                  {}
+func (log *Logger) Info(msg string, fields ...Field)                   {}
+func (log *Logger) Warn(msg string, fields ...Field)                   {}
+func (log *Logger) Error(msg string, fields ...Field)                  {}
+func (log *Logger) DPanic(msg string, fields ...Field)                 {}
+func (log *Logger) Panic(msg string, fields ...Field)                  {}
+func (log *Logger) Fatal(msg string, fields ...Field)                  {}
+func (log *Logger) With(fields ...Field) *Logger                       { return log }
+func (log *Logger) Log(lvl zapcore.Level, msg string, fields ...Field) {}
+func (log *Logger) Named(s string) *Logger                             { return log }
+func (log *Logger) Sugar() *SugaredLogger                              { return &SugaredLogger{} }
+
+type SugaredLogger struct{}
+
+func (s *SugaredLogger) Debug(args ...interface{})                                        {}
+func (s *SugaredLogger) Info(args ...interface{})                                         {}
+func (s *SugaredLogger) Warn(args ...interface{})                                         {}
+func (s *SugaredLogger) Error(args ...interface{})                                        {}
+func (s *SugaredLogger) Debugf(template string, args ...interface{})                      {}
+func (s *SugaredLogger) Infof(template string, args ...interface{})                       {}
+func (s *SugaredLogger) Warnf(template string, args ...interface{})                       {}
+func (s *SugaredLogger) Errorf(template string, args ...interface{})                      {}
+func (s *SugaredLogger) Debugw(msg string, keysAndValues ...interface{})                  {}
+func (s *SugaredLogger) Infow(msg string, keysAndValues ...interface{})                   {}
+func (s *SugaredLogger) Warnw(msg string, keysAndValues ...interface{})                   {}
+func (s *SugaredLogger) Errorw(msg string, keysAndValues ...interface{})                  {}
+func (s *SugaredLogger) Log(lvl zapcore.Level, args ...interface{})                       {}
+func (s *SugaredLogger) Logf(lvl zapcore.Level, template string, args ...interface{})     {}
+func (s *SugaredLogger) Logw(lvl zapcore.Level, msg string, keysAndValues ...interface{}) {}
+func (s *SugaredLogger) With(args ...interface{}) *SugaredLogger                          { return s }
+func (s *SugaredLogger) Named(name string) *SugaredLogger                                 { return s }
+func (s *SugaredLogger) Desugar() *Logger                                                 { return &Logger{} }
@@ -0,0 +1,14 @@
+// Package zapcore is a minimal stub of go.uber.org/zap/zapcore for analysistest.
+package zapcore
+
+type Level int8
+
+const (
+	DebugLevel Level = iota - 1
+	InfoLevel
+	WarnLevel
+	ErrorLevel
+	DPanicLevel
+	PanicLevel
+	FatalLevel
+)
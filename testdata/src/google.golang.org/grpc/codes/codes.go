@@ -0,0 +1,10 @@
+// Package codes is a minimal stub of google.golang.org/grpc/codes for analysistest.
+package codes
+
+type Code uint32
+
+const (
+	OK       Code = 0
+	NotFound Code = 5
+	Internal Code = 13
+)
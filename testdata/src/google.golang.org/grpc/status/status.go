@@ -0,0 +1,11 @@
+// Package status is a minimal stub of google.golang.org/grpc/status for analysistest.
+package status
+
+import "google.golang.org/grpc/codes"
+
+type Status struct{}
+
+func New(c codes.Code, msg string) *Status                       { return nil }
+func Newf(c codes.Code, format string, a ...interface{}) *Status { return nil }
+func Error(c codes.Code, msg string) error                       { return nil }
+func Errorf(c codes.Code, format string, a ...interface{}) error { return nil }
@@ -0,0 +1,38 @@
+package key_collisions
+
+import (
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+func reserved(m string, err error) {
+	slog.Info("request", "msg", m)                            // want `attribute key "msg" is reserved by the log handler`
+	slog.Info("request", slog.String("level", m))             // want `attribute key "level" is reserved by the log handler`
+	slog.Default().With("time", m).Info("request")            // want `attribute key "time" is reserved by the log handler`
+	zap.L().Info("request", zap.String("ts", m))              // want `attribute key "ts" is reserved by the log handler`
+	logr.Discard().Info("request", "error", err)              // want `attribute key "error" is reserved by the log handler`
+	slog.Info("request", slog.Group("http", "msg", m))        // nested keys do not clash
+	slog.Default().WithGroup("req").Info("request", "msg", m) // nested keys do not clash
+}
+
+func duplicates(id, id2 string) {
+	logger := slog.Default()
+
+	logger.With("request_id", id).Info("x", "request_id", id2) // want `duplicate attribute key "request_id"`
+	slog.Info("x", "user", id, slog.String("user", id2))       // want `duplicate attribute key "user"`
+
+	reqLogger := logger.With("request_id", id)
+	reqLogger.Info("x", "request_id", id2) // want `duplicate attribute key "request_id"`
+	reqLogger.Info("x", "user_id", id2)
+
+	grouped := reqLogger.WithGroup("http")
+	grouped.Info("x", "request_id", id2)
+
+	sugar := zap.L().With(zap.String("request_id", id)).Sugar()
+	sugar.Infow("x", "request_id", id2) // want `duplicate attribute key "request_id"`
+
+	zap.L().Info("x", zap.Namespace("req"), zap.String("id", id), zap.String("id", id2)) // want `duplicate attribute key "req.id"`
+	zap.L().Info("x", zap.String("id", id), zap.Namespace("req"), zap.String("id", id2))
+}
@@ -0,0 +1,21 @@
+package key_registry
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+	"logkeys"
+)
+
+func bad(id string, n int) {
+	slog.Info("user created", "user_id", id)              // want `attribute key "user_id" must use logkeys.UserID`
+	slog.Info("batch", slog.Int("count", n))              // want `attribute key "count" must use logkeys.Count`
+	zap.L().Info("request", zap.String("request_id", id)) // want `attribute key "request_id" must use logkeys.RequestID`
+	slog.Info("user created", "tenant", id)               // want `attribute key "tenant" has no entry in key registry "logkeys"`
+}
+
+func good(id string) {
+	slog.Info("user created", logkeys.UserID, id)
+	zap.L().Info("request", zap.String(logkeys.RequestID, id))
+	slog.Info("batch", slog.Group("stats", logkeys.Count, 1))
+}
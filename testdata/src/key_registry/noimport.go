@@ -0,0 +1,8 @@
+package key_registry
+
+import "log/slog"
+
+func noImport(id string) {
+	slog.Info("user created", "user_id", id) // want `attribute key "user_id" must use logkeys.UserID`
+	slog.Info("user deleted", "user_id", id) // want `attribute key "user_id" must use logkeys.UserID`
+}
@@ -0,0 +1,33 @@
+package key_style
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+const keyUserID = "userID"
+
+func bad(ctx context.Context, id string, n int) {
+	logger := slog.Default()
+	sugar := zap.L().Sugar()
+
+	slog.Info("user created", "userID", id)                          // want `attribute key "userID" must be snake_case \("user_id"\)`
+	slog.InfoContext(ctx, "user created", slog.String("UserId", id)) // want `attribute key "UserId" must be snake_case \("user_id"\)`
+	slog.Info("batch", slog.Group("batchInfo", "itemCount", n))      // want `attribute key "batchInfo" must be snake_case` `attribute key "itemCount" must be snake_case`
+	logger.With("requestID", id).Info("request")                     // want `attribute key "requestID" must be snake_case`
+	logger.WithGroup("httpRequest").Info("request")                  // want `attribute key "httpRequest" must be snake_case`
+	slog.Info("user created", keyUserID, id)                         // want `attribute key "userID" must be snake_case$`
+	zap.L().Info("user created", zap.String("user-id", id))          // want `attribute key "user-id" must be snake_case \("user_id"\)`
+	sugar.Infow("user created", "UserID", id)                        // want `attribute key "UserID" must be snake_case \("user_id"\)`
+	logr.Discard().WithValues("traceID", id).Info("request")         // want `attribute key "traceID" must be snake_case \("trace_id"\)`
+}
+
+func good(id string, err error) {
+	slog.Info("user created", "user_id", id, slog.Int("count", 1))
+	slog.Info("user created", "requestURI", id) // listed in exceptions
+	zap.L().Error("request failed", zap.Error(err))
+	slog.Info("dynamic", id, 1)
+}
@@ -0,0 +1,7 @@
+package dep // want package:"keyKinds\\(latency:number, user_id:number\\)"
+
+import "log/slog"
+
+func LogUser(id int, latency float64) {
+	slog.Info("user loaded", "user_id", id, slog.Float64("latency", latency))
+}
@@ -0,0 +1,28 @@
+package key_types // want package:"keyKinds\\(count:number, enabled:bool, latency:duration, tags:number, user_id:string\\)"
+
+import (
+	"log/slog"
+	"time"
+
+	"go.uber.org/zap"
+
+	"key_types/dep"
+)
+
+func bad(id string, n int, d time.Duration) {
+	dep.LogUser(1, 0.5)
+
+	slog.Info("user created", "user_id", id)          // want `attribute key "user_id" is logged as string, but as number in package key_types/dep`
+	slog.Info("request", slog.Duration("latency", d)) // want `attribute key "latency" is logged as duration, but as number in package key_types/dep`
+	slog.Info("batch", slog.Int("count", n))
+	zap.L().Info("batch", zap.String("count", id)) // want `attribute key "count" is logged as string, but as number at key_types.go:17`
+	slog.Info("flags", "enabled", true)
+	slog.Info("flags", "enabled", "yes")     // want `attribute key "enabled" is logged as string, but as bool at key_types.go:19`
+	slog.Info("user updated", "user_id", id) // want `attribute key "user_id" is logged as string, but as number in package key_types/dep`
+}
+
+func good(id string, n int, tags []string) {
+	slog.Info("batch", "count", 2*n)
+	slog.Info("tags", "tags", tags)
+	slog.Info("tags", "tags", len(tags))
+}
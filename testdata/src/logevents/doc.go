@@ -0,0 +1,4 @@
+// Package logevents holds logging helpers generated from events.json.
+package logevents
+
+//go:generate go run github.com/idakhno/golangster/cmd/golangster gen -schema events.json -o events_gen.go
@@ -0,0 +1,62 @@
+// Code generated by golangster gen from events.json. DO NOT EDIT.
+
+package logevents
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// OrderPlaced logs the "order placed" event at info level.
+func OrderPlaced(ctx context.Context, logger *slog.Logger, orderID string, amount int64, opts ...OrderPlacedOption) {
+	var o orderPlacedOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	logger.LogAttrs(ctx, slog.LevelInfo, "order placed",
+		slog.String("order_id", orderID),
+		slog.Int64("amount", amount),
+		o.coupon,
+		o.discount,
+	)
+}
+
+// OrderPlacedOption sets an optional attribute of the "order placed" event.
+type OrderPlacedOption func(*orderPlacedOptions)
+
+type orderPlacedOptions struct {
+	coupon   slog.Attr
+	discount slog.Attr
+}
+
+// OrderPlacedCoupon sets the optional "coupon" attribute of the "order placed" event.
+func OrderPlacedCoupon(coupon string) OrderPlacedOption {
+	return func(o *orderPlacedOptions) {
+		o.coupon = slog.String("coupon", coupon)
+	}
+}
+
+// OrderPlacedDiscount sets the optional "discount" attribute of the "order placed" event.
+func OrderPlacedDiscount(discount float64) OrderPlacedOption {
+	return func(o *orderPlacedOptions) {
+		o.discount = slog.Float64("discount", discount)
+	}
+}
+
+// PaymentTimedOut logs the "payment provider timed out" event at warn level.
+func PaymentTimedOut(ctx context.Context, logger *slog.Logger, provider string, elapsed time.Duration, retry bool) {
+	logger.LogAttrs(ctx, slog.LevelWarn, "payment provider timed out",
+		slog.String("provider", provider),
+		slog.Duration("elapsed", elapsed),
+		slog.Bool("retry", retry),
+	)
+}
+
+// CacheRefreshed logs the "cache refreshed" event.
+func CacheRefreshed(ctx context.Context, logger *slog.Logger, level slog.Level, sourceURL string, typeValue any) {
+	logger.LogAttrs(ctx, level, "cache refreshed",
+		slog.String("source_url", sourceURL),
+		slog.Any("type", typeValue),
+	)
+}
@@ -0,0 +1,8 @@
+// Package logkeys is the attribute key registry used by the key_registry testdata.
+package logkeys
+
+const (
+	UserID    = "user_id"
+	RequestID = "request_id"
+	Count     = "count"
+)
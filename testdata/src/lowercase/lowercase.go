@@ -3,6 +3,9 @@ package lowercase
 import (
 	"log"
 	"log/slog"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
 )
 
 func bad() {
@@ -27,3 +30,40 @@ func withLogger() {
 	logger.Info("Starting service") // want `log message must start with a lowercase letter`
 	logger.Info("starting service") // OK
 }
+
+func withZapLevels() {
+	zap.L().Log(zap.InfoLevel, "Worker stopped")                   // want `log message must start with a lowercase letter`
+	zap.L().Sugar().Log(zap.InfoLevel, "Worker started")           // want `log message must start with a lowercase letter`
+	zap.L().Sugar().Logf(zap.WarnLevel, "Worker %d retrying", 1)   // want `log message must start with a lowercase letter`
+	zap.L().Sugar().Logw(zap.ErrorLevel, "Worker failed", "id", 1) // want `log message must start with a lowercase letter`
+}
+
+func withLogrus(user string) {
+	logrus.New().Log(logrus.InfoLevel, "Starting worker")                  // want `log message must start with a lowercase letter`
+	logrus.WithField("user", user).Logf(logrus.WarnLevel, "User %s", user) // want `log message must start with a lowercase letter`
+	logrus.Traceln("Cache warmed")                                         // want `log message must start with a lowercase letter`
+	logrus.New().Warning("Disk almost full")                               // want `log message must start with a lowercase letter`
+	logrus.WithField("user", user).Infoln("Session opened")                // want `log message must start with a lowercase letter`
+	logrus.New().Logln(logrus.InfoLevel, "Worker stopped")                 // want `log message must start with a lowercase letter`
+}
+
+type Handler struct{}
+
+func NewHandler() *Handler { return nil }
+
+func words() {
+	slog.Info("HTTP server started")
+	slog.Error("ID not found")
+	slog.Info("IDs loaded")
+	slog.Error("JSON decode failed")
+	slog.Warn("TLS handshake error")
+	slog.Info("Kafka consumer started")
+	slog.Info("Postgres pool ready")
+	slog.Error("NewHandler failed")
+	slog.Info("Handler registered")
+	slog.Info("Handlers registered")     // want `log message must start with a lowercase letter`
+	slog.Info("A request failed")        // want `log message must start with a lowercase letter`
+	slog.Info("Kafkas are many")         // want `log message must start with a lowercase letter`
+	slog.Info("\u00c9chec de connexion") // want `log message must start with a lowercase letter` `log message must be in English only, found "\x{c9}chec"`
+	slog.Info(`Connecting to "primary"`) // want `log message must start with a lowercase letter`
+}
@@ -0,0 +1,65 @@
+package message_length
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+const prefix = "payment provider "
+
+func limits(name string, n int) {
+	slog.Info("connection established")
+	slog.Info("err")                                                            // want `log message has 1 word, at least 2 required`
+	slog.Info("here")                                                           // want `log message has 1 word, at least 2 required`
+	slog.Info("the payment provider rejected the request because it timed out") // want `log message is 62 characters long, limit is 40`
+	slog.Info("one two three four five six seven")                              // want `log message has 7 words, limit is 6`
+	slog.Info(prefix + "returned an unexpected status code")                    // want `log message is 51 characters long, limit is 40`
+	slog.Info("user " + name + " logged in in a very long time indeed")
+}
+
+func formats(name string, n int, err error) {
+	log.Printf("user %s logged in", name)
+	log.Println("failed:", err)
+	log.Printf("%s", name)                                                        // want `log message has 1 word, at least 2 required`
+	slog.Info(fmt.Sprintf("processed %d items in %d batches for %s", n, n, name)) // want `log message has 8 words, limit is 6`
+	slog.Info(fmt.Sprintf("100%% of %d", n))
+}
+
+func levels(ctx context.Context) {
+	slog.Debug("the payment provider rejected the request because it timed out")
+	slog.Debug("the payment provider rejected the request because it timed out after retrying twice with backoff") // want `log message is 96 characters long, limit is 80`
+	slog.Error("payment failed")                                                                                   // want `log message has 2 words, at least 3 required`
+	slog.Log(ctx, slog.LevelError, "payment failed")                                                               // want `log message has 2 words, at least 3 required`
+	slog.Log(ctx, slog.LevelDebug, "one two three four five six seven")
+	logrus.New().Log(logrus.ErrorLevel, "payment failed")               // want `log message has 2 words, at least 3 required`
+	logrus.WithField("id", 1).Logf(logrus.ErrorLevel, "payment failed") // want `log message has 2 words, at least 3 required`
+	logrus.New().Log(logrus.TraceLevel, "one two three four five six seven")
+	zap.L().Log(zap.ErrorLevel, "payment failed")                   // want `log message has 2 words, at least 3 required`
+	zap.L().Sugar().Logw(zap.ErrorLevel, "payment failed", "id", 1) // want `log message has 2 words, at least 3 required`
+	zap.L().Sugar().Logf(zap.DebugLevel, "one two three four five six seven")
+}
+
+func logrusMethods() {
+	logrus.Trace("one two three four five six seven")
+	logrus.New().Tracef("one two three four five six %s", "seven")
+	logrus.WithField("id", 1).Traceln("one two three four five six seven")
+	logrus.Debugln("one two three four five six seven")
+	logrus.Print("one two three four five six seven")                         // want `log message has 7 words, limit is 6`
+	logrus.New().Println("one two three four five six seven")                 // want `log message has 7 words, limit is 6`
+	logrus.Infoln("one two three four five six seven")                        // want `log message has 7 words, limit is 6`
+	logrus.Warnln("one two three four five six seven")                        // want `log message has 7 words, limit is 6`
+	logrus.New().Warning("one two three four five six seven")                 // want `log message has 7 words, limit is 6`
+	logrus.WithField("id", 1).Warningf("one two three four five six %s", "x") // want `log message has 7 words, limit is 6`
+	logrus.Warningln("one two three four five six seven")                     // want `log message has 7 words, limit is 6`
+	logrus.Errorln("payment failed")                                          // want `log message has 2 words, at least 3 required`
+	logrus.WithField("id", 1).Errorln("payment failed")                       // want `log message has 2 words, at least 3 required`
+	logrus.New().Fatalln("one two three four five six seven")                 // want `log message has 7 words, limit is 6`
+	logrus.Panicln("one two three four five six seven")                       // want `log message has 7 words, limit is 6`
+	logrus.New().Logln(logrus.ErrorLevel, "payment failed")                   // want `log message has 2 words, at least 3 required`
+	logrus.New().Logln(logrus.TraceLevel, "one two three four five six seven")
+}
@@ -0,0 +1,49 @@
+package message_shape
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func punctuation(name string) {
+	slog.Info("connection failed.")      // want `log message must not end with a period`
+	slog.Info("loading config:")         // want `log message must not end with a colon`
+	slog.Info("user " + name + " left.") // want `log message must not end with a period`
+	slog.Info("config: " + name)
+	slog.Info("version 1.2 loaded")
+	slog.Info("waiting...")
+	log.Printf("read %d bytes.", 3) // want `log message must not end with a period`
+	log.Print("user: ", name)
+}
+
+func whitespace(name string) {
+	slog.Info("starting server ")   // want `log message must not end with whitespace`
+	slog.Info("  starting server")  // want `log message must not start with whitespace`
+	slog.Info("retrying\n")         // want `log message must not end with whitespace`
+	slog.Info("line1\nline2")       // want `log message must not contain a newline`
+	slog.Info("key\tvalue")         // want `log message must not contain a tab`
+	slog.Info("a \r\n b")           // want `log message must not contain a carriage return`
+	slog.Info("too  many   spaces") // want `log message must not contain repeated spaces` `log message must not contain repeated spaces`
+	slog.Info("done. ")             // want `log message must not end with whitespace` `log message must not end with a period`
+	slog.Info("user " + name + " left")
+	zap.L().Info("café  opened")                // want `log message must not contain repeated spaces`
+	zap.L().Sugar().Infow("job done.", "id", 1) // want `log message must not end with a period`
+}
+
+func stdlog(name string) {
+	log.Print("server started\n")
+	log.Printf("user %s logged in\n", name)
+	log.Println("server started\n") // want `log message must not end with a newline, Println adds one`
+	log.Print("starting ", name)
+}
+
+func errorStrings() error {
+	if true {
+		return errors.New("open failed.") // want `error string must not end with a period`
+	}
+	return fmt.Errorf("%w:  retry later", errors.ErrUnsupported) // want `error string must not contain repeated spaces`
+}
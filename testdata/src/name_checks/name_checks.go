@@ -0,0 +1,43 @@
+package name_checks
+
+import (
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+const ticketKey = "ticket"
+
+const (
+	accountKey = "\u0441\u0447\u0435\u0442"
+	cartKey    = "cart?"
+	workerName = "worker!"
+)
+
+func keys() {
+	slog.Info("order created", "\u0441\u0447\u0435\u0442", 1)         // want `attribute key must be in English only, found Cyrillic script "\x{441}\x{447}\x{435}\x{442}"`
+	slog.Info("order created", "\U0001F525count", 1)                  // want `attribute key must not contain emoji`
+	slog.Info("order created", slog.Int("retries!", 3))               // want `attribute key must not contain special character '!'`
+	slog.Info("order created", slog.Group("cart?", "items", 2))       // want `group name must not contain special character '\?'`
+	slog.With("tenant", "acme").WithGroup("r\u00e9seau").Info("sent") // want `group name must be in English only, found "r\x{e9}seau"`
+	zap.L().Info("order created", zap.Namespace("payment!"))          // want `group name must not contain special character '!'`
+	slog.Info("order created", "user id", 1)                          // want `attribute key must not contain whitespace`
+	slog.Info("order created", accountKey, 1)                         // want `attribute key must be in English only, found Cyrillic script "\x{441}\x{447}\x{435}\x{442}"`
+	slog.Info("order created", slog.Int(cartKey, 1))                  // want `attribute key must not contain special character '\?'`
+	slog.Info("order created", slog.Group("cart items", "n", 2))      // want `group name must not contain whitespace`
+}
+
+func loggers(log logr.Logger) {
+	zap.L().Named("payments!").Info("order created")                           // want `logger name must not contain special character '!'`
+	zap.L().Sugar().Named("\U0001F4E6cart").Info("started")                    // want `logger name must not contain emoji`
+	zap.L().Named(workerName).Info("started")                                  // want `logger name must not contain special character '!'`
+	log.WithName("\u043a\u043e\u0440\u0437\u0438\u043d\u0430").Info("started") // want `logger name must be in English only, found Cyrillic script "\x{43a}\x{43e}\x{440}\x{437}\x{438}\x{43d}\x{430}"`
+}
+
+func good(log logr.Logger) {
+	slog.Info("order created", "order_id", 1, ticketKey, 2)
+	slog.Info("order created", slog.Group("http.request", "method", "GET"))
+	zap.L().Named("payments").Info("order created", zap.String("user.id", "u1"))
+	log.WithName("checkout").Info("started")
+}
@@ -0,0 +1,13 @@
+package ranges
+
+import "log/slog"
+
+func messages() {
+	slog.Info("Starting server")                     // want `log message must start with a lowercase letter`
+	slog.Info("\x41bc started")                      // want `log message must start with a lowercase letter`
+	slog.Info("\u0434\u0430 and \u043d\u0435\u0442") // want `log message must be in English only` `log message must be in English only`
+	slog.Info("done!! really?")                      // want `repeated punctuation "!!"` `special character '\?'`
+	slog.Info("launch \U0001F680 ok \U0001F389")     // want `log message must not contain emoji` `log message must not contain emoji`
+	slog.Info("wait... and wait\x2e..")              // want `ellipsis` `ellipsis`
+	slog.Info("password and token")                  // want `keyword: "password"` `keyword: "token"`
+}
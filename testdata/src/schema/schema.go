@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+const msgOrderPlaced = "order placed"
+
+func matching(ctx context.Context, id string, amount int64, err error) {
+	slog.Info("order placed", "order_id", id, "amount", amount)
+	slog.Info(msgOrderPlaced, slog.String("order_id", id), slog.Int64("amount", amount), "coupon", "spring")
+	slog.Log(ctx, slog.LevelInfo, "order placed", "order_id", id, "amount", amount)
+	slog.Info("order placed", "order_id", id, "amount", amount, slog.Group("http", "method", "GET"))
+	zap.L().Error("payment failed", zap.Error(err))
+	logr.Discard().Error(err, "payment failed", "error", err)
+
+	logger := slog.Default().With("order_id", id)
+	logger.Info("order placed", "amount", amount)
+}
+
+func unknown(msg string) {
+	slog.Info("order shipped") // want `log message "order shipped" is not declared in the event schema`
+	slog.Info(msg)             // want `log message must be a constant declared in the event schema`
+}
+
+func level(id string, amount int64) {
+	slog.Warn("order placed", "order_id", id, "amount", amount)                                  // want `event "order placed" must be logged at info level, not warn`
+	slog.LogAttrs(context.Background(), slog.LevelDebug, "payment failed", slog.Any("error", 1)) // want `event "payment failed" must be logged at error level, not debug`
+}
+
+func attributes(id string, amount int64, price float64) {
+	slog.Info("order placed", "order_id", id)                                  // want `event "order placed" is missing required attribute "amount"`
+	slog.Info("order placed", "order_id", id, "amount", amount, "user", "bob") // want `event "order placed" has unexpected attribute "user"`
+	slog.Info("order placed", "order_id", id, "amount", "12")                  // want `attribute "amount" of event "order placed" must be number, not string`
+	slog.Info("order placed", slog.String("order_id", id), slog.Float64("amount", price))
+	zap.L().Info("order placed", zap.String("order_id", id), zap.Bool("amount", true)) // want `attribute "amount" of event "order placed" must be number, not bool`
+
+	logger := slog.Default().With("user", "bob")
+	logger.Info("order placed", "order_id", id, "amount", amount) // want `event "order placed" has unexpected attribute "user"`
+}
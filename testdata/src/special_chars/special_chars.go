@@ -4,12 +4,26 @@ import "log/slog"
 
 func bad() {
 	slog.Info("server started 🚀")        // want `log message must not contain emoji`
-	slog.Error("connection failed!!!")   // want `log message must not contain special character '!'`
+	slog.Error("connection failed!!!")   // want `log message must not contain repeated punctuation "!!!"`
 	slog.Warn("something went wrong...") // want `log message must not contain`
 	slog.Debug("are you sure?")          // want `log message must not contain special character`
 	slog.Info("hello world!")            // want `log message must not contain special character '!'`
 }
 
+func fixes() {
+	slog.Info("launch \U0001F680 ok")                  // want `log message must not contain emoji`
+	slog.Info("\U0001F525\U0001F525 hot path")         // want `log message must not contain emoji`
+	slog.Info("dev \U0001F468\u200d\U0001F4BB joined") // want `log message must not contain emoji`
+	slog.Info("warning \u26a0\ufe0f disk full")        // want `log message must not contain emoji`
+	slog.Info("failed! retrying")                      // want `log message must not contain special character '!'`
+	slog.Info("really?!")                              // want `log message must not contain repeated punctuation "\?!"`
+	slog.Info("why?? again")                           // want `log message must not contain repeated punctuation "\?\?"`
+	slog.Info("loading…")                              // want `log message must not contain '…' \(ellipsis\)`
+	slog.Info("loading... please wait")                // want `log message must not contain '...' \(ellipsis\)`
+	slog.Info("done ✅ and saved \U0001F4BE")           // want `log message must not contain emoji` `log message must not contain emoji`
+	slog.Info(`raw done!`)                             // want `log message must not contain special character '!'`
+}
+
 func good() {
 	slog.Info("server started")
 	slog.Error("connection failed")
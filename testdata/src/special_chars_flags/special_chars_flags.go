@@ -0,0 +1,10 @@
+package special_chars_flags
+
+import "log/slog"
+
+func levels() {
+	slog.Info(`request "ok"`) // want `log message must not contain special character '"'` `log message must not contain special character '"'`
+	slog.Info("user, created")
+	slog.Debug("user, created") // want `log message must not contain special character ','`
+	slog.Error(`request "ok"`)
+}
@@ -0,0 +1,23 @@
+package special_chars_policy
+
+import "log/slog"
+
+func forbidden() {
+	slog.Info("user \"admin\" logged in") // want `log message must not contain special character '"'` `log message must not contain special character '"'`
+	slog.Info("run `make` first")         // want "log message must not contain special character '`'" "log message must not contain special character '`'"
+	slog.Info("disk full!")               // want `log message must not contain special character '!'`
+	slog.Info("is it ready?")             // want `log message must not contain special character '\?'`
+}
+
+func allowed() {
+	slog.Info("request \u2192 upstream")
+	slog.Info("temperature 40\u00b0 reached")
+	slog.Debug("is it ready?")
+	slog.Debug("disk full!") // want `log message must not contain special character '!'`
+}
+
+func emoji() {
+	slog.Info("copyright \u00a9 notice") // want `log message must not contain emoji`
+	slog.Info("launch \U0001F680 ok")    // want `log message must not contain emoji`
+	slog.Info("swap \u2194 sides")       // want `log message must not contain emoji`
+}
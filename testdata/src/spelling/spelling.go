@@ -0,0 +1,25 @@
+package spelling
+
+import (
+	"errors"
+	"log"
+	"log/slog"
+)
+
+func messages(id string, n int) {
+	slog.Info("conection refused")          // want `log message has misspelled word "conection", use "connection"`
+	slog.Info("Recieved message", "id", id) // want `log message has misspelled word "Recieved", use "Received"`
+	slog.Info("closing idle conections")    // want `log message has misspelled word "conections", use "connections"`
+	slog.Info("retrying \u00e9 conection")  // want `log message has misspelled word "conection", use "connection"`
+	log.Printf("proccessed %d itmes", n)    // want `log message has misspelled word "proccessed", use "processed"` `log message has misspelled word "itmes", use "items"`
+	slog.Info("connection established")
+	slog.Info("fetching https://exmaple.com/helath")
+	slog.Info("request a1b2c3d4 finished")
+	slog.Info("kubelet restarted by golangster")
+	slog.Info("TIMOEUT reached")
+	slog.Info("invalid userNmae_valeu field") // want `log message has misspelled word "valeu", use "value"`
+}
+
+func errorStrings() error {
+	return errors.New("unexpectd token") // want `error string has misspelled word "unexpectd", use "unexpected"`
+}
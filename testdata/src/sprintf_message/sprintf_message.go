@@ -0,0 +1,31 @@
+package sprintf_message
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+	"time"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+type order struct{ ID int64 }
+
+func bad(n int, dur time.Duration, name string, o order, ratio float64, ok bool, err error, tags []string) {
+	slog.Info(fmt.Sprintf("processed %d items in %s", n, dur))    // want `log message must not be built with fmt.Sprintf, use a constant message and typed attributes`
+	slog.Warn(fmt.Sprintf("user %q has ratio %.2f", name, ratio)) // want `log message must not be built with fmt.Sprintf`
+	slog.Error(fmt.Sprintf("order %d failed: %v", o.ID, err))     // want `log message must not be built with fmt.Sprintf`
+	slog.Debug(fmt.Sprintf("tags %v ok=%t", tags, ok))            // want `log message must not be built with fmt.Sprintf`
+	zap.L().Info(fmt.Sprintf("processed %d items in %s", n, dur)) // want `log message must not be built with fmt.Sprintf`
+	zap.L().Error(fmt.Sprintf("order %d failed: %v", o.ID, err))  // want `log message must not be built with fmt.Sprintf`
+	logr.Discard().Info(fmt.Sprintf("user %s logged in", name))   // want `log message must not be built with fmt.Sprintf`
+	slog.Info(fmt.Sprintf("%[1]d items", n))                      // want `log message must not be built with fmt.Sprintf`
+	slog.Info(fmt.Sprintf("%d items", len(tags)))                 // want `log message must not be built with fmt.Sprintf`
+}
+
+func good(n int) {
+	slog.Info("processed items", slog.Int("n", n))
+	log.Print(fmt.Sprintf("processed %d items", n))
+	zap.L().Sugar().Info(fmt.Sprintf("processed %d items", n))
+}
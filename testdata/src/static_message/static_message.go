@@ -0,0 +1,36 @@
+package static_message
+
+import (
+	"context"
+	"log"
+	"log/slog"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+)
+
+type user struct{ ID string }
+
+const startMsg = "starting server"
+
+func bad(ctx context.Context, id string, err error, u user, msg string) {
+	slog.Info("user " + id + " created")                             // want `log message must be a constant string, pass dynamic values as attributes`
+	slog.Error("failed: " + err.Error())                             // want `log message must be a constant string`
+	slog.InfoContext(ctx, "loaded user "+u.ID)                       // want `log message must be a constant string`
+	slog.LogAttrs(ctx, slog.LevelInfo, "user "+id+" deleted")        // want `log message must be a constant string`
+	slog.Warn(msg)                                                   // want `log message must be a constant string`
+	zap.L().Info("user " + id + " created")                          // want `log message must be a constant string`
+	zap.L().Error("request failed: "+err.Error(), zap.Int("try", 1)) // want `log message must be a constant string`
+	logr.Discard().Error(err, "user "+id+" not found")               // want `log message must be a constant string`
+	zap.L().Log(zap.InfoLevel, "user "+id+" created")                // want `log message must be a constant string`
+}
+
+func good(id string) {
+	slog.Info("user created", "id", id)
+	slog.Info(startMsg)
+	slog.Info("starting" + " server")
+	zap.L().Info("user created", zap.String("id", id))
+	zap.L().Sugar().Infof("user %s created", id)
+	logr.Discard().Info("user created", "id", id)
+	log.Print("user " + id + " created")
+}
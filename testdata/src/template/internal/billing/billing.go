@@ -0,0 +1,10 @@
+package billing
+
+import "log/slog"
+
+func charge(id string) {
+	slog.Info("charge created", "id", id)
+	slog.Info("billing: charge created", "id", id)    // want `log message must not match "\^billing:": the logger already adds the component`
+	slog.Error("billing: failed to charge", "id", id) // want `log message does not match "\^\(failed to\|cannot\) "` `log message must not match "\^billing:"`
+	slog.Error("charge declined", "id", id)           // want `log message does not match "\^\(failed to\|cannot\) "`
+}
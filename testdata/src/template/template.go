@@ -0,0 +1,36 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+const failed = "failed to "
+
+func levels(ctx context.Context, logger *zap.Logger, err error) {
+	slog.Error("failed to connect to database", "err", err)
+	slog.Error("cannot open file", "err", err)
+	slog.Error("connection lost", "err", err)         // want `log message does not match "\^\(failed to\|cannot\) ": error messages start with "failed to" or "cannot"`
+	slog.Log(ctx, slog.LevelError, "connection lost") // want `log message does not match "\^\(failed to\|cannot\) ": error messages start with "failed to" or "cannot"`
+	slog.Error(failed + "send request")
+	slog.Error(fmt.Sprintf("retry %d exhausted", 3)) // want `log message does not match "\^\(failed to\|cannot\) "`
+	logger.Error("connection lost", zap.Error(err))  // want `log message does not match "\^\(failed to\|cannot\) "`
+	logger.Sugar().Error("connection lost")          // want `log message does not match "\^\(failed to\|cannot\) "`
+	logger.Sugar().Error("connection lost: ", err)   // the error is printed after the message
+	slog.Info("connection lost")
+	log.Print("connection lost")
+}
+
+func loggers(logger *zap.Logger) {
+	slog.Warn("please check the configuration")
+	logger.Warn("please check the configuration") // want `log message must not match "\(\?i\)\^please ": zap messages are not requests`
+	logger.Info("Please retry now")               // want `log message must not match "\(\?i\)\^please "`
+}
+
+func dynamic(err error) {
+	slog.Error(err.Error())
+}
@@ -0,0 +1,26 @@
+package terminology
+
+import (
+	"errors"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func messages(id string) {
+	slog.Info("db connection established")      // want `log message uses "db", use "database"`
+	slog.Info("DB connection lost")             // want `log message uses "DB", use "Database"`
+	slog.Info("request processed successfully") // want `log message must not contain "successfully"`
+	slog.Info("Successfully stopped worker")    // want `log message must not contain "Successfully"`
+	slog.Info("oops, retrying")                 // want `log message must not contain "oops"`
+	slog.Info("retrying (oops)")                // want `log message must not contain "oops"`
+	slog.Info("user failed to log in")          // want `log message uses "log in", use "sign in"`
+	slog.Info("login failed\tfor " + id)        // want `log message uses "login", use "sign in"`
+	slog.Info("caf\u00e9 db offline", "id", id) // want `log message uses "db", use "database"`
+	slog.Info("dbx and user_db are fine")
+	zap.L().Info("TODO: handle db errors") // want `log message must not contain "TODO"` `log message uses "db", use "database"`
+}
+
+func errorStrings() error {
+	return errors.New("db unavailable") // want `error string uses "db", use "database"`
+}
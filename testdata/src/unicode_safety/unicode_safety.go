@@ -0,0 +1,28 @@
+package unicode_safety
+
+import "log/slog"
+
+const hiddenKey = "user\u200bid"
+
+func messages() {
+	slog.Info("access granted \u202e\u2066admin\u2069") // want `log message must not contain bidirectional control U\+202E \(RIGHT-TO-LEFT OVERRIDE\)` `log message must not contain bidirectional control U\+2066 \(LEFT-TO-RIGHT ISOLATE\)` `log message must not contain bidirectional control U\+2069 \(POP DIRECTIONAL ISOLATE\)`
+	slog.Info("user\u200b logged in")                   // want `log message must not contain invisible character U\+200B \(ZERO WIDTH SPACE\)`
+	slog.Info("\ufeffserver started")                   // want `log message must not contain invisible character U\+FEFF \(ZERO WIDTH NO-BREAK SPACE\)`
+	slog.Info("\x1b[31mrequest failed\x1b[0m")          // want `log message must not contain ANSI escape sequence "\\x1b\[31m"` `log message must not contain ANSI escape sequence "\\x1b\[0m"`
+	slog.Info("terminal bell\a")                        // want `log message must not contain control character U\+0007 \(BEL\)`
+	slog.Info("null\x00byte")                           // want `log message must not contain control character U\+0000 \(NUL\)`
+	slog.Info("next line\u0085follows")                 // want `log message must not contain control character U\+0085 \(C1 CONTROL\)`
+	slog.Info("title \x1b]0;pwned\a set")               // want `log message must not contain ANSI escape sequence "\\x1b\]0;pwned\\a"`
+}
+
+func keys() {
+	slog.Info("user created", "user\u202eid", 1)          // want `attribute key must not contain bidirectional control U\+202E \(RIGHT-TO-LEFT OVERRIDE\)`
+	slog.Info("user created", slog.Int("count\u200d", 1)) // want `attribute key must not contain invisible character U\+200D \(ZERO WIDTH JOINER\)`
+	slog.Info("user created", hiddenKey, 1)               // want `attribute key must not contain invisible character U\+200B \(ZERO WIDTH SPACE\)`
+}
+
+func good() {
+	slog.Info("request completed", "status", 200)
+	slog.Info("dev \U0001F468\u200d\U0001F4BB joined")
+	slog.Info("tab\tand newline\n are left to message-shape")
+}